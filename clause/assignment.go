@@ -0,0 +1,97 @@
+package clause
+
+import (
+	"errors"
+)
+
+// Assignment assign the value to the property in the SET clause of the update statement, the value may be an expression
+// referencing the property itself, such as age = age + 1. in both UPDATE VERTEX and UPDATE EDGE the property of the
+// updated tag or edge is referenced by its name, so the same assignment can be used to update vertices and edges.
+//
+// the assignment can be used as the update value directly, as the value of the update map, or be appended to the
+// struct based update by WithSet. as the value of the update map, the prop of the assignment must be the same as the
+// key, otherwise the update fails.
+type Assignment struct {
+	Prop  string
+	Value interface{}
+}
+
+// Set assign the value or the expression to the property
+func Set(prop string, value interface{}) Assignment {
+	return Assignment{Prop: prop, Value: value}
+}
+
+// Incr increase the property by n
+//
+// age = age + 1
+// Incr("age", 1)
+func Incr(prop string, n interface{}) Assignment {
+	return Assignment{Prop: prop, Value: Expr{Str: prop + " + ?", Vars: []interface{}{n}}}
+}
+
+// Decr decrease the property by n
+//
+// age = age - 1
+// Decr("age", 1)
+func Decr(prop string, n interface{}) Assignment {
+	return Assignment{Prop: prop, Value: Expr{Str: prop + " - ?", Vars: []interface{}{n}}}
+}
+
+// Append append the values to the list property
+//
+// tags = tags + ["mvp", "fmvp"]
+// Append("tags", "mvp", "fmvp")
+func Append(prop string, values ...interface{}) Assignment {
+	return Assignment{Prop: prop, Value: Expr{Str: prop + " + ?", Vars: []interface{}{values}}}
+}
+
+// Coalesce keep the property if it is not NULL, otherwise assign the value to it
+//
+// nickname = coalesce(nickname, "unknown")
+// Coalesce("nickname", "unknown")
+func Coalesce(prop string, value interface{}) Assignment {
+	return Assignment{Prop: prop, Value: Expr{Str: "coalesce(" + prop + ", ?)", Vars: []interface{}{value}}}
+}
+
+// Now the current timestamp of the server, use Expr{Str: "datetime()"} for the datetime property
+//
+// updated = now()
+// Set("updated", Now())
+func Now() Expr {
+	return Expr{Str: "now()"}
+}
+
+// build the value of the assignment
+func (a Assignment) buildValue() (string, error) {
+	if a.Prop == "" {
+		return "", errors.New("the prop of the assignment is empty")
+	}
+	switch v := a.Value.(type) {
+	case nil:
+		return "NULL", nil
+	default:
+		return Expr{}.formatValue(v)
+	}
+}
+
+// applyAssignments apply the assignments to the update set, the assignment replaces the update of the same property
+func applyAssignments(propsUpdateSet [][2]string, assignments []Assignment) ([][2]string, error) {
+	for _, a := range assignments {
+		value, err := a.buildValue()
+		if err != nil {
+			return nil, err
+		}
+		replaced := false
+		for i := range propsUpdateSet {
+			if propsUpdateSet[i][0] == a.Prop {
+				propsUpdateSet[i][1] = value
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			propsUpdateSet = append(propsUpdateSet, [2]string{a.Prop, value})
+		}
+	}
+	return propsUpdateSet, nil
+}
@@ -0,0 +1,70 @@
+package clause_test
+
+import (
+	"fmt"
+	"github.com/haysons/nebulaorm/clause"
+	"testing"
+)
+
+func TestAssignment(t *testing.T) {
+	e21 := &edge2{SrcID: "player100", DstID: "team204", Rank: 2}
+	tests := []struct {
+		clauses []clause.Interface
+		gqlWant string
+		errWant error
+	}{
+		{
+			clauses: []clause.Interface{clause.UpdateVertex{VID: "player101", TagUpdate: clause.Incr("age", 1), Opts: optsOf(clause.WithTagName("player"))}},
+			gqlWant: `UPDATE VERTEX ON player "player101" SET age = age + 1`,
+		},
+		{
+			clauses: []clause.Interface{clause.UpdateVertex{VID: "player101", TagUpdate: []clause.Assignment{clause.Decr("age", 2), clause.Set("updated", clause.Now())}, Opts: optsOf(clause.WithTagName("player"))}},
+			gqlWant: `UPDATE VERTEX ON player "player101" SET age = age - 2, updated = now()`,
+		},
+		{
+			clauses: []clause.Interface{clause.UpdateVertex{VID: "player101", TagUpdate: &playerTag{Name: "hayson"}, Opts: optsOf(clause.WithSet(clause.Incr("age", 1), clause.Append("tags", "mvp", "fmvp")))}},
+			gqlWant: `UPDATE VERTEX ON player "player101" SET name = "hayson", age = age + 1, tags = tags + ["mvp", "fmvp"]`,
+		},
+		{
+			clauses: []clause.Interface{clause.UpdateVertex{VID: "player101", TagUpdate: &playerTag{Name: "hayson", Age: 26}, Opts: optsOf(clause.WithSet(clause.Coalesce("name", "unknown")))}},
+			gqlWant: `UPDATE VERTEX ON player "player101" SET name = coalesce(name, "unknown"), age = 26`,
+		},
+		{
+			clauses: []clause.Interface{clause.UpdateVertex{VID: "player101", TagUpdate: playerUpdate{"age": clause.Incr("age", 1), "nickname": clause.Set("nickname", nil)}}},
+			gqlWant: `UPDATE VERTEX ON player "player101" SET age = age + 1, nickname = NULL`,
+		},
+		{
+			clauses: []clause.Interface{clause.UpdateEdge{Edge: e21, PropsUpdate: &edge2{Name: "hayson"}, Opts: optsOf(clause.WithSet(clause.Incr("age", 1)))}},
+			gqlWant: `UPDATE EDGE ON e2 "player100"->"team204"@2 SET name = "hayson", age = age + 1`,
+		},
+		{
+			clauses: []clause.Interface{clause.UpdateEdge{IsUpsert: true, Edge: e21, PropsUpdate: clause.Coalesce("age", 0)}},
+			gqlWant: `UPSERT EDGE ON e2 "player100"->"team204"@2 SET age = coalesce(age, 0)`,
+		},
+		{
+			clauses: []clause.Interface{clause.UpdateVertex{VID: "player101", TagUpdate: playerUpdate{"age": clause.Incr("level", 1)}}},
+			errWant: clause.ErrInvalidClauseParams,
+		},
+		{
+			clauses: []clause.Interface{clause.UpdateVertex{VID: "player101", TagUpdate: clause.Incr("", 1), Opts: optsOf(clause.WithTagName("player"))}},
+			errWant: clause.ErrInvalidClauseParams,
+		},
+		{
+			clauses: []clause.Interface{clause.UpdateVertex{VID: "player101", Opts: optsOf(clause.WithTagName("player"))}},
+			errWant: clause.ErrInvalidClauseParams,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			testBuildClauses(t, tt.clauses, tt.gqlWant, tt.errWant)
+		})
+	}
+}
+
+func optsOf(opts ...clause.Option) clause.Options {
+	var o clause.Options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
@@ -5,6 +5,9 @@ import "errors"
 var (
 	// ErrInvalidClauseParams indicates that the argument to the clause is invalid
 	ErrInvalidClauseParams = errors.New("invalid clause params")
+
+	// ErrMissingWhereClause indicates that the statement deleting the matched items has no where clause
+	ErrMissingWhereClause = errors.New("missing where clause")
 )
 
 // Interface clause interface
@@ -32,8 +35,9 @@ func (c Clause) Build(nGQL Builder) error {
 
 // Options 子句配置项
 type Options struct {
-	propNames []string // 指定属性列表
-	tagName   string   // 指定tag名称
+	propNames   []string     // 指定属性列表
+	tagName     string       // 指定tag名称
+	assignments []Assignment // 额外的属性赋值
 }
 
 type Option func(*Options)
@@ -50,3 +54,10 @@ func WithTagName(tagName string) Option {
 		o.tagName = tagName
 	}
 }
+
+// WithSet 追加属性赋值，同名属性以赋值为准，可与结构体更新一起使用
+func WithSet(assignments ...Assignment) Option {
+	return func(o *Options) {
+		o.assignments = append(o.assignments, assignments...)
+	}
+}
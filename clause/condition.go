@@ -0,0 +1,324 @@
+package clause
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Eq prop == value, prop IS NULL if the value is nil
+type Eq struct {
+	Prop  string
+	Value interface{}
+}
+
+func (eq Eq) Build(nGQL Builder) error {
+	if eq.Value == nil {
+		return IsNull{Prop: eq.Prop}.Build(nGQL)
+	}
+	return buildComparison(nGQL, eq.Prop, "==", eq.Value)
+}
+
+// Neq prop != value, prop IS NOT NULL if the value is nil
+type Neq struct {
+	Prop  string
+	Value interface{}
+}
+
+func (neq Neq) Build(nGQL Builder) error {
+	if neq.Value == nil {
+		return IsNotNull{Prop: neq.Prop}.Build(nGQL)
+	}
+	return buildComparison(nGQL, neq.Prop, "!=", neq.Value)
+}
+
+// Gt prop > value
+type Gt struct {
+	Prop  string
+	Value interface{}
+}
+
+func (gt Gt) Build(nGQL Builder) error {
+	return buildComparison(nGQL, gt.Prop, ">", gt.Value)
+}
+
+// Gte prop >= value
+type Gte struct {
+	Prop  string
+	Value interface{}
+}
+
+func (gte Gte) Build(nGQL Builder) error {
+	return buildComparison(nGQL, gte.Prop, ">=", gte.Value)
+}
+
+// Lt prop < value
+type Lt struct {
+	Prop  string
+	Value interface{}
+}
+
+func (lt Lt) Build(nGQL Builder) error {
+	return buildComparison(nGQL, lt.Prop, "<", lt.Value)
+}
+
+// Lte prop <= value
+type Lte struct {
+	Prop  string
+	Value interface{}
+}
+
+func (lte Lte) Build(nGQL Builder) error {
+	return buildComparison(nGQL, lte.Prop, "<=", lte.Value)
+}
+
+// In prop IN [values], the values is a slice or an expression of list
+type In struct {
+	Prop   string
+	Values interface{}
+}
+
+func (in In) Build(nGQL Builder) error {
+	return buildComparison(nGQL, in.Prop, "IN", in.Values)
+}
+
+// NotIn prop NOT IN [values]
+type NotIn struct {
+	Prop   string
+	Values interface{}
+}
+
+func (notIn NotIn) Build(nGQL Builder) error {
+	return buildComparison(nGQL, notIn.Prop, "NOT IN", notIn.Values)
+}
+
+// Contains prop CONTAINS value
+type Contains struct {
+	Prop  string
+	Value interface{}
+}
+
+func (contains Contains) Build(nGQL Builder) error {
+	return buildComparison(nGQL, contains.Prop, "CONTAINS", contains.Value)
+}
+
+// StartsWith prop STARTS WITH value
+type StartsWith struct {
+	Prop  string
+	Value interface{}
+}
+
+func (startsWith StartsWith) Build(nGQL Builder) error {
+	return buildComparison(nGQL, startsWith.Prop, "STARTS WITH", startsWith.Value)
+}
+
+// EndsWith prop ENDS WITH value
+type EndsWith struct {
+	Prop  string
+	Value interface{}
+}
+
+func (endsWith EndsWith) Build(nGQL Builder) error {
+	return buildComparison(nGQL, endsWith.Prop, "ENDS WITH", endsWith.Value)
+}
+
+// IsNull prop IS NULL
+type IsNull struct {
+	Prop string
+}
+
+func (isNull IsNull) Build(nGQL Builder) error {
+	if isNull.Prop == "" {
+		return fmt.Errorf("nebulaorm: %w, the prop of condition is empty", ErrInvalidClauseParams)
+	}
+	nGQL.WriteString(isNull.Prop)
+	nGQL.WriteString(" IS NULL")
+	return nil
+}
+
+// IsNotNull prop IS NOT NULL
+type IsNotNull struct {
+	Prop string
+}
+
+func (isNotNull IsNotNull) Build(nGQL Builder) error {
+	if isNotNull.Prop == "" {
+		return fmt.Errorf("nebulaorm: %w, the prop of condition is empty", ErrInvalidClauseParams)
+	}
+	nGQL.WriteString(isNotNull.Prop)
+	nGQL.WriteString(" IS NOT NULL")
+	return nil
+}
+
+func buildComparison(nGQL Builder, prop string, op string, value interface{}) error {
+	if prop == "" {
+		return fmt.Errorf("nebulaorm: %w, the prop of condition is empty", ErrInvalidClauseParams)
+	}
+	valueFmt, err := Expr{}.formatValue(value)
+	if err != nil {
+		return err
+	}
+	nGQL.WriteString(prop)
+	nGQL.WriteByte(' ')
+	nGQL.WriteString(op)
+	nGQL.WriteByte(' ')
+	nGQL.WriteString(valueFmt)
+	return nil
+}
+
+// AndConditions the conditions joined by AND
+type AndConditions struct {
+	Exprs []Expression
+}
+
+// And join the conditions by AND, the condition containing other logical operators is enclosed in parentheses
+//
+// player.age > 30 AND (player.name == "Tim Duncan" OR player.name == "Tony Parker")
+// And(Gt{Prop: "player.age", Value: 30}, Or(Eq{Prop: "player.name", Value: "Tim Duncan"}, Eq{Prop: "player.name", Value: "Tony Parker"}))
+func And(exprs ...Expression) AndConditions {
+	return AndConditions{Exprs: exprs}
+}
+
+func (and AndConditions) Build(nGQL Builder) error {
+	return buildLogical(nGQL, OperatorAnd, and.Exprs)
+}
+
+// OrConditions the conditions joined by OR
+type OrConditions struct {
+	Exprs []Expression
+}
+
+// Or join the conditions by OR
+func Or(exprs ...Expression) OrConditions {
+	return OrConditions{Exprs: exprs}
+}
+
+func (or OrConditions) Build(nGQL Builder) error {
+	return buildLogical(nGQL, OperatorOr, or.Exprs)
+}
+
+// XorConditions the conditions joined by XOR
+type XorConditions struct {
+	Exprs []Expression
+}
+
+// Xor join the conditions by XOR
+func Xor(exprs ...Expression) XorConditions {
+	return XorConditions{Exprs: exprs}
+}
+
+func (xor XorConditions) Build(nGQL Builder) error {
+	return buildLogical(nGQL, OperatorXor, xor.Exprs)
+}
+
+// NotConditions the negation of the condition
+type NotConditions struct {
+	Expr Expression
+}
+
+// Not negate the condition
+//
+// NOT (player.age > 30 AND player.age < 40)
+// Not(And(Gt{Prop: "player.age", Value: 30}, Lt{Prop: "player.age", Value: 40}))
+func Not(expr Expression) NotConditions {
+	return NotConditions{Expr: expr}
+}
+
+func (not NotConditions) Build(nGQL Builder) error {
+	if not.Expr == nil {
+		return fmt.Errorf("nebulaorm: %w, the condition of NOT is empty", ErrInvalidClauseParams)
+	}
+	nGQL.WriteString("NOT ")
+	return buildNestedCondition(nGQL, not.Expr)
+}
+
+func buildLogical(nGQL Builder, op string, exprs []Expression) error {
+	if len(exprs) == 0 {
+		return fmt.Errorf("nebulaorm: %w, the conditions of %s are empty", ErrInvalidClauseParams, op)
+	}
+	for i, expr := range exprs {
+		if expr == nil {
+			return fmt.Errorf("nebulaorm: %w, the condition of %s is nil", ErrInvalidClauseParams, op)
+		}
+		if i > 0 {
+			nGQL.WriteByte(' ')
+			nGQL.WriteString(op)
+			nGQL.WriteByte(' ')
+		}
+		if len(exprs) == 1 {
+			if err := expr.Build(nGQL); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := buildNestedCondition(nGQL, expr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildNestedCondition build the condition, and enclose it in parentheses if it contains logical operators outside
+// the parentheses and string literals, so the precedence is kept when it is combined with other conditions
+func buildNestedCondition(nGQL Builder, expr Expression) error {
+	exprBuilder := new(strings.Builder)
+	if err := expr.Build(exprBuilder); err != nil {
+		return err
+	}
+	cond := exprBuilder.String()
+	if hasLogicalOperator(cond) {
+		nGQL.WriteByte('(')
+		nGQL.WriteString(cond)
+		nGQL.WriteByte(')')
+		return nil
+	}
+	nGQL.WriteString(cond)
+	return nil
+}
+
+// hasLogicalOperator whether the condition contains AND, OR, XOR or NOT outside the brackets and string literals
+func hasLogicalOperator(cond string) bool {
+	var quote byte
+	var depth int
+	for i := 0; i < len(cond); i++ {
+		c := cond[i]
+		if quote != 0 {
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		switch c {
+		case '"', '\'', '`':
+			quote = c
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+		case ' ':
+			if depth > 0 {
+				continue
+			}
+			for _, op := range []string{OperatorAnd, OperatorOr, OperatorXor, OperatorNot} {
+				end := i + 1 + len(op)
+				if end < len(cond) && cond[end] == ' ' && strings.EqualFold(cond[i+1:end], op) {
+					// IS NOT NULL and NOT IN are comparisons rather than negations
+					if op == OperatorNot && (hasSuffixFold(cond[:i], " IS") || hasPrefixFold(cond[end:], " IN ")) {
+						continue
+					}
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func hasSuffixFold(s, suffix string) bool {
+	return len(s) >= len(suffix) && strings.EqualFold(s[len(s)-len(suffix):], suffix)
+}
+
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
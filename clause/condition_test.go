@@ -0,0 +1,72 @@
+package clause_test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/haysons/nebulaorm/clause"
+	"strings"
+	"testing"
+)
+
+func TestCondition(t *testing.T) {
+	tests := []struct {
+		cond    clause.Expression
+		want    string
+		errWant error
+	}{
+		{cond: clause.Eq{Prop: "player.name", Value: "Tim Duncan"}, want: `player.name == "Tim Duncan"`},
+		{cond: clause.Eq{Prop: "player.name"}, want: `player.name IS NULL`},
+		{cond: clause.Neq{Prop: "player.age", Value: 33}, want: `player.age != 33`},
+		{cond: clause.Neq{Prop: "player.age"}, want: `player.age IS NOT NULL`},
+		{cond: clause.Gt{Prop: "player.age", Value: 30}, want: `player.age > 30`},
+		{cond: clause.Gte{Prop: "player.age", Value: 30}, want: `player.age >= 30`},
+		{cond: clause.Lt{Prop: "player.age", Value: 30}, want: `player.age < 30`},
+		{cond: clause.Lte{Prop: "player.age", Value: 30}, want: `player.age <= 30`},
+		{cond: clause.In{Prop: "player.age", Values: []int{25, 28}}, want: `player.age IN [25, 28]`},
+		{cond: clause.NotIn{Prop: "player.name", Values: []string{"Anne", "John"}}, want: `player.name NOT IN ["Anne", "John"]`},
+		{cond: clause.Contains{Prop: "player.name", Value: "an"}, want: `player.name CONTAINS "an"`},
+		{cond: clause.StartsWith{Prop: "player.name", Value: "T"}, want: `player.name STARTS WITH "T"`},
+		{cond: clause.EndsWith{Prop: "player.name", Value: "n"}, want: `player.name ENDS WITH "n"`},
+		{cond: clause.IsNull{Prop: "player.age"}, want: `player.age IS NULL`},
+		{cond: clause.IsNotNull{Prop: "player.age"}, want: `player.age IS NOT NULL`},
+		{cond: clause.Gt{Prop: "properties(edge).degree", Value: clause.Expr{Str: "$-.degree"}}, want: `properties(edge).degree > $-.degree`},
+		{
+			cond: clause.And(clause.Gt{Prop: "player.age", Value: 30}, clause.Or(clause.Eq{Prop: "player.name", Value: "Tim Duncan"}, clause.Eq{Prop: "player.name", Value: "Tony Parker"})),
+			want: `player.age > 30 AND (player.name == "Tim Duncan" OR player.name == "Tony Parker")`,
+		},
+		{
+			cond: clause.Or(clause.And(clause.Gt{Prop: "player.age", Value: 30}, clause.Lt{Prop: "player.age", Value: 40}), clause.IsNotNull{Prop: "player.name"}, clause.NotIn{Prop: "player.age", Values: []int{1}}),
+			want: `(player.age > 30 AND player.age < 40) OR player.name IS NOT NULL OR player.age NOT IN [1]`,
+		},
+		{
+			cond: clause.Not(clause.And(clause.Gt{Prop: "player.age", Value: 30}, clause.Lt{Prop: "player.age", Value: 40})),
+			want: `NOT (player.age > 30 AND player.age < 40)`,
+		},
+		{
+			cond: clause.And(clause.Not(clause.Eq{Prop: "player.name", Value: "Tim"}), clause.Xor(clause.Eq{Prop: "player.age", Value: 1}, clause.Eq{Prop: "player.age", Value: 2})),
+			want: `NOT player.name == "Tim" AND (player.age == 1 XOR player.age == 2)`,
+		},
+		{
+			cond: clause.And(clause.Eq{Prop: "player.name", Value: "Tom and Jerry"}, clause.Expr{Str: "player.age > ? OR player.age < ?", Vars: []interface{}{40, 20}}),
+			want: `player.name == "Tom and Jerry" AND (player.age > 40 OR player.age < 20)`,
+		},
+		{cond: clause.And(clause.Eq{Prop: "player.name", Value: "Tim"}), want: `player.name == "Tim"`},
+		{cond: clause.And(), errWant: clause.ErrInvalidClauseParams},
+		{cond: clause.Or(clause.Eq{Prop: "player.name", Value: "Tim"}, nil), errWant: clause.ErrInvalidClauseParams},
+		{cond: clause.Not(nil), errWant: clause.ErrInvalidClauseParams},
+		{cond: clause.Eq{Value: 1}, errWant: clause.ErrInvalidClauseParams},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			b := new(strings.Builder)
+			err := tt.cond.Build(b)
+			if !errors.Is(err, tt.errWant) {
+				t.Errorf("Build() error = %v, errWant %v", err, tt.errWant)
+				return
+			}
+			if err == nil && b.String() != tt.want {
+				t.Errorf("Build() got = %v, want %v", b.String(), tt.want)
+			}
+		})
+	}
+}
@@ -29,8 +29,27 @@ func (de DeleteEdge) Build(nGQL Builder) error {
 	}
 	nGQL.WriteString(de.EdgeTypeName)
 	nGQL.WriteByte(' ')
+	edgeList, err := edgeKeyList("delete_edge", de.Edges)
+	if err != nil {
+		return err
+	}
+	if len(edgeList) == 0 {
+		return fmt.Errorf("nebulaorm: %w, build delete_edge clause failed, edge list is empty", ErrInvalidClauseParams)
+	}
+	for i, e := range edgeList {
+		nGQL.WriteString(e)
+		if i < len(edgeList)-1 {
+			nGQL.WriteString(", ")
+		}
+	}
+	return nil
+}
+
+// edgeKeyList get the keys of the edges, such as "player100"->"team204"@1, the edges can be a string, []string, edge
+// struct, or slice or array of edge struct
+func edgeKeyList(clauseName string, edges interface{}) ([]string, error) {
 	edgeList := make([]string, 0)
-	switch edge := de.Edges.(type) {
+	switch edge := edges.(type) {
 	case string:
 		edgeList = append(edgeList, edge)
 	case []string:
@@ -38,16 +57,20 @@ func (de DeleteEdge) Build(nGQL Builder) error {
 	default:
 		edgeValue := reflect.Indirect(reflect.ValueOf(edge))
 		if !edgeValue.IsValid() {
-			return fmt.Errorf("nebulaorm: %w, build delete_edge clause failed, edge must be a string, []string, edge, edge slice or edge array", ErrInvalidClauseParams)
+			return nil, fmt.Errorf("nebulaorm: %w, build %s clause failed, edge must be a string, []string, edge, edge slice or edge array", ErrInvalidClauseParams, clauseName)
 		}
 		edgeType := edgeValue.Type()
 		switch edgeType.Kind() {
 		case reflect.Struct:
 			edgeSchema, err := resolver.ParseEdge(edgeType)
 			if err != nil {
-				return err
+				return nil, err
 			}
-			edgeList = append(edgeList, edgeIDExpr(edgeSchema, edgeValue))
+			edgeID, err := edgeIDExpr(edgeSchema, edgeValue)
+			if err != nil {
+				return nil, err
+			}
+			edgeList = append(edgeList, edgeID)
 		case reflect.Slice, reflect.Array:
 			edgeType = edgeType.Elem()
 			if edgeType.Kind() == reflect.Ptr {
@@ -56,38 +79,37 @@ func (de DeleteEdge) Build(nGQL Builder) error {
 			if edgeType.Kind() == reflect.Struct {
 				edgeSchema, err := resolver.ParseEdge(edgeType)
 				if err != nil {
-					return err
+					return nil, err
 				}
 				for i := 0; i < edgeValue.Len(); i++ {
 					curValue := reflect.Indirect(edgeValue.Index(i))
-					edgeList = append(edgeList, edgeIDExpr(edgeSchema, curValue))
+					edgeID, err := edgeIDExpr(edgeSchema, curValue)
+					if err != nil {
+						return nil, err
+					}
+					edgeList = append(edgeList, edgeID)
 				}
 			} else {
-				return fmt.Errorf("nebulaorm: %w, build delete_edge clause failed, slice element must be a struct or a struct pointer", ErrInvalidClauseParams)
+				return nil, fmt.Errorf("nebulaorm: %w, build %s clause failed, slice element must be a struct or a struct pointer", ErrInvalidClauseParams, clauseName)
 			}
 		default:
-			return fmt.Errorf("nebulaorm: %w, build delete_edge clause failed, edge must be a string, []string, edge, edge slice or edge array", ErrInvalidClauseParams)
+			return nil, fmt.Errorf("nebulaorm: %w, build %s clause failed, edge must be a string, []string, edge, edge slice or edge array", ErrInvalidClauseParams, clauseName)
 		}
 	}
-	if len(edgeList) == 0 {
-		return fmt.Errorf("nebulaorm: %w, build delete_edge clause failed, edge list is empty", ErrInvalidClauseParams)
-	}
-	for i, e := range edgeList {
-		nGQL.WriteString(e)
-		if i < len(edgeList)-1 {
-			nGQL.WriteString(", ")
-		}
-	}
-	return nil
+	return edgeList, nil
 }
 
-func edgeIDExpr(edgeSchema *resolver.EdgeSchema, edgeValue reflect.Value) string {
+// edgeIDExpr get the key of the edge, such as "player100"->"team204"@1, an error is returned if the src or dst is empty
+func edgeIDExpr(edgeSchema *resolver.EdgeSchema, edgeValue reflect.Value) (string, error) {
 	srcID := edgeSchema.GetSrcVIDExpr(edgeValue)
 	dstID := edgeSchema.GetDstVIDExpr(edgeValue)
+	if srcID == "" || dstID == "" {
+		return "", fmt.Errorf("nebulaorm: %w, the src_id or dst_id of edge %s is empty", ErrInvalidClauseParams, edgeSchema.GetTypeName())
+	}
 	rank := edgeSchema.GetRank(edgeValue)
 	edgeStr := srcID + "->" + dstID
 	if rank > 0 {
 		edgeStr += "@" + strconv.Itoa(int(rank))
 	}
-	return edgeStr
+	return edgeStr, nil
 }
@@ -58,6 +58,14 @@ func TestDeleteEdge(t *testing.T) {
 			clauses: []clause.Interface{clause.DeleteEdge{Edges: []edgeTest{edge1, edge2, edge3}}},
 			errWant: clause.ErrInvalidClauseParams,
 		},
+		{
+			clauses: []clause.Interface{clause.DeleteEdge{EdgeTypeName: "edge_test", Edges: &edgeTest{DstID: "team204"}}},
+			errWant: clause.ErrInvalidClauseParams,
+		},
+		{
+			clauses: []clause.Interface{clause.DeleteEdge{EdgeTypeName: "edge_test", Edges: []edgeTest{edge1, {SrcID: "player100"}}}},
+			errWant: clause.ErrInvalidClauseParams,
+		},
 	}
 	for i, tt := range tests {
 		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
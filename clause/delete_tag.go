@@ -0,0 +1,98 @@
+package clause
+
+import (
+	"errors"
+	"fmt"
+	"github.com/haysons/nebulaorm/resolver"
+	"reflect"
+	"strings"
+)
+
+type DeleteTag struct {
+	TagNames interface{} // tag name, *, struct implementing resolver.VertexTagNamer, or a slice of them
+	VID      interface{}
+}
+
+const DeleteTagName = "DELETE_TAG"
+
+func (dt DeleteTag) Name() string {
+	return DeleteTagName
+}
+
+func (dt DeleteTag) MergeIn(clause *Clause) {
+	clause.Expression = dt
+}
+
+func (dt DeleteTag) Build(nGQL Builder) error {
+	tagNames, err := tagNameList(dt.TagNames)
+	if err != nil {
+		return fmt.Errorf("nebulaorm: %w, build delete_tag clause failed, %v", ErrInvalidClauseParams, err)
+	}
+	if len(tagNames) == 0 {
+		return fmt.Errorf("nebulaorm: %w, build delete_tag clause failed, tag names are empty", ErrInvalidClauseParams)
+	}
+	for _, tagName := range tagNames {
+		if tagName == "*" && len(tagNames) > 1 {
+			return fmt.Errorf("nebulaorm: %w, build delete_tag clause failed, * can not be mixed with other tag names", ErrInvalidClauseParams)
+		}
+	}
+	vidExpr, err := vertexIDExpr(dt.VID)
+	if err != nil {
+		return fmt.Errorf("nebulaorm: %w, build delete_tag clause failed, %v", ErrInvalidClauseParams, err)
+	}
+	if vidExpr == "" {
+		return fmt.Errorf("nebulaorm: %w, build delete_tag clause failed, vid is empty", ErrInvalidClauseParams)
+	}
+	nGQL.WriteString("DELETE TAG ")
+	nGQL.WriteString(strings.Join(tagNames, ", "))
+	nGQL.WriteString(" FROM ")
+	nGQL.WriteString(vidExpr)
+	return nil
+}
+
+// tagNameList get the tag names from the name, the tag struct, or a slice of them
+func tagNameList(tagNames interface{}) ([]string, error) {
+	switch names := tagNames.(type) {
+	case string:
+		if names == "" {
+			return nil, errors.New("tag name is empty")
+		}
+		return []string{names}, nil
+	case []string:
+		for _, name := range names {
+			if name == "" {
+				return nil, errors.New("tag name is empty")
+			}
+		}
+		return names, nil
+	}
+	value := reflect.ValueOf(tagNames)
+	if !value.IsValid() {
+		return nil, nil
+	}
+	if value.Kind() == reflect.Slice || value.Kind() == reflect.Array {
+		list := make([]string, 0, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			elemNames, err := tagNameList(value.Index(i).Interface())
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, elemNames...)
+		}
+		return list, nil
+	}
+	// the namer may be implemented by the pointer receiver, the tag name of the nil pointer is got from a new value of
+	// the type, so that the value receiver is not called on nil
+	if value.Kind() == reflect.Ptr && value.IsNil() {
+		value = reflect.New(value.Type().Elem())
+	} else if value.Kind() != reflect.Ptr {
+		ptr := reflect.New(value.Type())
+		ptr.Elem().Set(value)
+		value = ptr
+	}
+	namer, ok := value.Interface().(resolver.VertexTagNamer)
+	if !ok {
+		return nil, fmt.Errorf("tag must be a tag name or implement VertexTagNamer, got %T", tagNames)
+	}
+	return []string{namer.VertexTagName()}, nil
+}
@@ -0,0 +1,69 @@
+package clause_test
+
+import (
+	"fmt"
+	"github.com/haysons/nebulaorm/clause"
+	"testing"
+)
+
+func TestDeleteTag(t *testing.T) {
+	tests := []struct {
+		clauses []clause.Interface
+		gqlWant string
+		errWant error
+	}{
+		{
+			clauses: []clause.Interface{clause.DeleteTag{TagNames: "test1", VID: "test"}},
+			gqlWant: `DELETE TAG test1 FROM "test"`,
+		},
+		{
+			clauses: []clause.Interface{clause.DeleteTag{TagNames: []string{"test1", "test2"}, VID: []string{"test", "test2"}}},
+			gqlWant: `DELETE TAG test1, test2 FROM "test", "test2"`,
+		},
+		{
+			clauses: []clause.Interface{clause.DeleteTag{TagNames: "*", VID: clause.Expr{Str: "$-.id"}}},
+			gqlWant: `DELETE TAG * FROM $-.id`,
+		},
+		{
+			clauses: []clause.Interface{clause.DeleteTag{TagNames: &t1{}, VID: 101}},
+			gqlWant: `DELETE TAG t1 FROM 101`,
+		},
+		{
+			clauses: []clause.Interface{clause.DeleteTag{TagNames: []interface{}{t1{}, t3{}, "t4"}, VID: "test"}},
+			gqlWant: `DELETE TAG t1, t3, t4 FROM "test"`,
+		},
+		{
+			clauses: []clause.Interface{clause.DeleteTag{TagNames: []interface{}{(*t1)(nil), (*t3)(nil)}, VID: "test"}},
+			gqlWant: `DELETE TAG t1, t3 FROM "test"`,
+		},
+		{
+			clauses: []clause.Interface{clause.DeleteTag{TagNames: []string{"t1", ""}, VID: "test"}},
+			errWant: clause.ErrInvalidClauseParams,
+		},
+		{
+			clauses: []clause.Interface{clause.DeleteTag{TagNames: []interface{}{t1{}, ""}, VID: "test"}},
+			errWant: clause.ErrInvalidClauseParams,
+		},
+		{
+			clauses: []clause.Interface{clause.DeleteTag{TagNames: []string{"*", "t1"}, VID: "test"}},
+			errWant: clause.ErrInvalidClauseParams,
+		},
+		{
+			clauses: []clause.Interface{clause.DeleteTag{VID: "test"}},
+			errWant: clause.ErrInvalidClauseParams,
+		},
+		{
+			clauses: []clause.Interface{clause.DeleteTag{TagNames: "t1"}},
+			errWant: clause.ErrInvalidClauseParams,
+		},
+		{
+			clauses: []clause.Interface{clause.DeleteTag{TagNames: 1, VID: "test"}},
+			errWant: clause.ErrInvalidClauseParams,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			testBuildClauses(t, tt.clauses, tt.gqlWant, tt.errWant)
+		})
+	}
+}
@@ -51,6 +51,14 @@ func (expr Expr) Build(builder Builder) error {
 	return nil
 }
 
+// Var reference the column of the nGQL variable, such as $ids.id, the variable itself is referenced if col is empty
+func Var(name string, col string) Expr {
+	if col == "" {
+		return Expr{Str: "$" + name}
+	}
+	return Expr{Str: "$" + name + "." + col}
+}
+
 func (expr Expr) formatValue(value interface{}) (string, error) {
 	switch v := value.(type) {
 	case Expr:
@@ -36,3 +36,12 @@ func Test_vertexIDExpr(t *testing.T) {
 		})
 	}
 }
+
+func TestVar(t *testing.T) {
+	if got := Var("ids", "id"); got.Str != "$ids.id" {
+		t.Errorf("Var() got = %v, want $ids.id", got.Str)
+	}
+	if got := Var("ids", ""); got.Str != "$ids" {
+		t.Errorf("Var() got = %v, want $ids", got.Str)
+	}
+}
@@ -2,11 +2,13 @@ package clause
 
 import (
 	"fmt"
+	"strings"
 )
 
 type Fetch struct {
 	Names []string
 	VID   interface{}
+	Edges interface{} // the keys of the edges to fetch, it takes precedence over VID, see DeleteEdge for the supported types
 }
 
 const FetchName = "FETCH"
@@ -33,6 +35,7 @@ func (fetch Fetch) MergeIn(clause *Clause) {
 		}
 	}
 	exist.VID = fetch.VID
+	exist.Edges = fetch.Edges
 	clause.Expression = exist
 }
 
@@ -48,6 +51,17 @@ func (fetch Fetch) Build(nGQL Builder) error {
 		}
 	}
 	nGQL.WriteByte(' ')
+	if fetch.Edges != nil {
+		edgeList, err := edgeKeyList("fetch", fetch.Edges)
+		if err != nil {
+			return err
+		}
+		if len(edgeList) == 0 {
+			return fmt.Errorf("nebulaorm: %w, build fetch clause failed, edge list is empty", ErrInvalidClauseParams)
+		}
+		nGQL.WriteString(strings.Join(edgeList, ", "))
+		return nil
+	}
 	vidExpr, err := vertexIDExpr(fetch.VID)
 	if err != nil {
 		return fmt.Errorf("nebulaorm: %w, build fetch clause failed, %v", ErrInvalidClauseParams, err)
@@ -32,6 +32,22 @@ func TestFetch(t *testing.T) {
 			clauses: []clause.Interface{clause.Fetch{Names: []string{"serve"}, VID: []*clause.Expr{{Str: `"player100" -> "team204"`}, {Str: `"player133" -> "team202"`}}}},
 			gqlWant: `FETCH PROP ON serve "player100" -> "team204", "player133" -> "team202"`,
 		},
+		{
+			clauses: []clause.Interface{clause.Fetch{Names: []string{"serve"}, Edges: `"player100"->"team204"@1`}},
+			gqlWant: `FETCH PROP ON serve "player100"->"team204"@1`,
+		},
+		{
+			clauses: []clause.Interface{clause.Fetch{Names: []string{"serve"}, Edges: []*edgeTest{{SrcID: "player100", DstID: "team204"}, {SrcID: "player101", DstID: "team204", Rank: 1}}}},
+			gqlWant: `FETCH PROP ON serve "player100"->"team204", "player101"->"team204"@1`,
+		},
+		{
+			clauses: []clause.Interface{clause.Fetch{Names: []string{"serve"}, Edges: 1}},
+			errWant: clause.ErrInvalidClauseParams,
+		},
+		{
+			clauses: []clause.Interface{clause.Fetch{Names: []string{"serve"}, Edges: &edgeTest{DstID: "team204"}}},
+			errWant: clause.ErrInvalidClauseParams,
+		},
 		{
 			clauses: []clause.Interface{clause.Fetch{Names: []string{"player"}}},
 			errWant: clause.ErrInvalidClauseParams,
@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"github.com/haysons/nebulaorm/resolver"
 	"reflect"
+	"time"
 )
 
 type InsertEdge struct {
 	IfNotExist bool
 	Edges      reflect.Value
+	Resolver   *resolver.Resolver // used to get the current time in the timezone of the server, default resolver if nil
 	edgeSchema *resolver.EdgeSchema
 }
 
@@ -38,7 +40,7 @@ func (ie InsertEdge) Build(nGQL Builder) error {
 		}
 		ie.buildPropNames(nGQL)
 		nGQL.WriteString(" VALUES ")
-		return ie.buildPropValues(ie.Edges, nGQL)
+		return ie.buildPropValues(ie.Edges, ie.Resolver.Now(), nGQL)
 	case reflect.Slice, reflect.Array:
 		var err error
 		edgeType := ie.Edges.Type().Elem()
@@ -52,9 +54,10 @@ func (ie InsertEdge) Build(nGQL Builder) error {
 		ie.buildPropNames(nGQL)
 		nGQL.WriteString(" VALUES ")
 		edgesLen := ie.Edges.Len()
+		now := ie.Resolver.Now()
 		for i := 0; i < edgesLen; i++ {
 			curValue := reflect.Indirect(ie.Edges.Index(i))
-			if err = ie.buildPropValues(curValue, nGQL); err != nil {
+			if err = ie.buildPropValues(curValue, now, nGQL); err != nil {
 				return err
 			}
 			if i != edgesLen-1 {
@@ -79,12 +82,21 @@ func (ie InsertEdge) buildPropNames(nGQL Builder) {
 	nGQL.WriteByte(')')
 }
 
-func (ie InsertEdge) buildPropValues(curValue reflect.Value, nGQL Builder) error {
-	nGQL.WriteString(edgeIDExpr(ie.edgeSchema, curValue))
+func (ie InsertEdge) buildPropValues(curValue reflect.Value, now time.Time, nGQL Builder) error {
+	validationErr := new(resolver.ValidationError)
+	for _, fieldErr := range ie.edgeSchema.ValidateVID(curValue) {
+		validationErr.Add(fieldErr)
+	}
+	// the empty src_id or dst_id is reported by the validation error above
+	edgeID, err := edgeIDExpr(ie.edgeSchema, curValue)
+	if err != nil && len(validationErr.Errors) == 0 {
+		return err
+	}
+	nGQL.WriteString(edgeID)
 	nGQL.WriteString(":(")
 	props := ie.edgeSchema.GetProps()
 	for i, prop := range props {
-		valueFmt, err := resolver.FormatSimpleValue(prop.NebulaType, curValue.FieldByIndex(prop.StructField.Index))
+		valueFmt, err := formatInsertValue(prop, curValue.FieldByIndex(prop.StructField.Index), now, validationErr)
 		if err != nil {
 			return err
 		}
@@ -94,5 +106,5 @@ func (ie InsertEdge) buildPropValues(curValue reflect.Value, nGQL Builder) error
 		}
 	}
 	nGQL.WriteString(")")
-	return nil
+	return validationErr.Err()
 }
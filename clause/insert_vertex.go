@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"github.com/haysons/nebulaorm/resolver"
 	"reflect"
+	"time"
 )
 
 type InsertVertex struct {
 	IfNotExist   bool
 	Vertexes     reflect.Value
+	Resolver     *resolver.Resolver // used to get the current time in the timezone of the server, default resolver if nil
 	vertexSchema *resolver.VertexSchema
 }
 
@@ -38,7 +40,7 @@ func (iv InsertVertex) Build(nGQL Builder) error {
 		}
 		iv.buildTagProps(nGQL)
 		nGQL.WriteString(" VALUES ")
-		return iv.buildPropValue(iv.Vertexes, nGQL)
+		return iv.buildPropValue(iv.Vertexes, iv.Resolver.Now(), nGQL)
 	case reflect.Slice, reflect.Array:
 		var err error
 		vertexType := iv.Vertexes.Type().Elem()
@@ -52,9 +54,10 @@ func (iv InsertVertex) Build(nGQL Builder) error {
 		iv.buildTagProps(nGQL)
 		nGQL.WriteString(" VALUES ")
 		vertexesLen := iv.Vertexes.Len()
+		now := iv.Resolver.Now()
 		for i := 0; i < vertexesLen; i++ {
 			curValue := reflect.Indirect(iv.Vertexes.Index(i))
-			if err = iv.buildPropValue(curValue, nGQL); err != nil {
+			if err = iv.buildPropValue(curValue, now, nGQL); err != nil {
 				return err
 			}
 			if i != vertexesLen-1 {
@@ -86,15 +89,17 @@ func (iv InsertVertex) buildTagProps(nGQL Builder) {
 	}
 }
 
-func (iv InsertVertex) buildPropValue(curValue reflect.Value, nGQL Builder) error {
+func (iv InsertVertex) buildPropValue(curValue reflect.Value, now time.Time, nGQL Builder) error {
 	tags := iv.vertexSchema.GetTags()
+	validationErr := new(resolver.ValidationError)
+	validationErr.Add(iv.vertexSchema.ValidateVID(curValue))
 	vid := iv.vertexSchema.GetVIDExpr(curValue)
 	nGQL.WriteString(vid)
 	nGQL.WriteString(":(")
 	for j, t := range tags {
 		props := t.GetProps()
 		for k, p := range props {
-			valueFmt, err := resolver.FormatSimpleValue(p.NebulaType, curValue.FieldByIndex(p.StructField.Index))
+			valueFmt, err := formatInsertValue(p, curValue.FieldByIndex(p.StructField.Index), now, validationErr)
 			if err != nil {
 				return err
 			}
@@ -108,5 +113,22 @@ func (iv InsertVertex) buildPropValue(curValue reflect.Value, nGQL Builder) erro
 		}
 	}
 	nGQL.WriteString(")")
-	return nil
+	return validationErr.Err()
+}
+
+// formatInsertValue format the value of the property to be inserted, the zero value is replaced with the current time
+// if the property is an auto time property, or with the default value if the property has a default value.
+// the value finally inserted is validated, and the validation error is collected into validationErr.
+func formatInsertValue(prop *resolver.Prop, value reflect.Value, now time.Time, validationErr *resolver.ValidationError) (string, error) {
+	if value.IsZero() {
+		if prop.AutoCreateTime != resolver.AutoTimeNone {
+			value = prop.AutoTimeValue(now, prop.AutoCreateTime)
+		} else if prop.AutoUpdateTime != resolver.AutoTimeNone {
+			value = prop.AutoTimeValue(now, prop.AutoUpdateTime)
+		} else if prop.Default != "" {
+			return prop.FormatDefault()
+		}
+	}
+	validationErr.Add(prop.Validate(value))
+	return prop.FormatValue(value)
 }
@@ -3,8 +3,10 @@ package clause_test
 import (
 	"fmt"
 	"github.com/haysons/nebulaorm/clause"
+	"github.com/haysons/nebulaorm/resolver"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestInsertVertex(t *testing.T) {
@@ -50,6 +52,22 @@ func TestInsertVertex(t *testing.T) {
 			clauses: []clause.Interface{clause.InsertVertex{IfNotExist: true, Vertexes: reflect.ValueOf([]v3{v31, *v32})}},
 			gqlWant: `INSERT VERTEX IF NOT EXISTS t3(p1), t4(p2) VALUES "21":(321, "hello"), "22":(456, "world")`,
 		},
+		{
+			clauses: []clause.Interface{clause.InsertVertex{Vertexes: reflect.ValueOf(&t5{VID: "31", Conf: map[string]int{"a": 1}, Tags: []string{"x"}})}},
+			gqlWant: `INSERT VERTEX t5(conf, tags) VALUES "31":("{\"a\":1}", "[\"x\"]")`,
+		},
+		{
+			clauses: []clause.Interface{clause.InsertVertex{Vertexes: reflect.ValueOf([]t6{{VID: "41"}, {VID: "42", Name: "n2", Age: 20, Created: time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)}})}},
+			gqlWant: `INSERT VERTEX t6(name, age, created) VALUES "41":("unknown", 18, datetime()), "42":("n2", 20, datetime("2024-01-01T00:00:00"))`,
+		},
+		{
+			clauses: []clause.Interface{clause.InsertVertex{Vertexes: reflect.ValueOf(&t7{VID: "51", Code: "abcd", Level: 127})}},
+			gqlWant: `INSERT VERTEX t7(code, level) VALUES "51":("abcd", 127)`,
+		},
+		{
+			clauses: []clause.Interface{clause.InsertVertex{Vertexes: reflect.ValueOf(&t7{Code: "abcde", Level: 128})}},
+			errWant: resolver.ErrValidationFailed,
+		},
 		{
 			clauses: []clause.Interface{clause.InsertVertex{IfNotExist: true}},
 			errWant: clause.ErrInvalidClauseParams,
@@ -113,3 +131,46 @@ type t4 struct {
 func (t t4) VertexTagName() string {
 	return "t4"
 }
+
+type t5 struct {
+	VID  string         `norm:"vertex_id"`
+	Conf map[string]int `norm:"serializer:json"`
+	Tags []string       `norm:"serializer:json"`
+}
+
+func (t t5) VertexID() string {
+	return t.VID
+}
+
+func (t t5) VertexTagName() string {
+	return "t5"
+}
+
+type t6 struct {
+	VID     string    `norm:"vertex_id"`
+	Name    string    `norm:"default:unknown"`
+	Age     int       `norm:"default:18"`
+	Created time.Time `norm:"default:datetime()"`
+}
+
+func (t t6) VertexID() string {
+	return t.VID
+}
+
+func (t t6) VertexTagName() string {
+	return "t6"
+}
+
+type t7 struct {
+	VID   string `norm:"vertex_id"`
+	Code  string `norm:"prop:code;not null;type:fixed_string(4)"`
+	Level int    `norm:"prop:level;type:int8"`
+}
+
+func (t t7) VertexID() string {
+	return t.VID
+}
+
+func (t t7) VertexTagName() string {
+	return "t7"
+}
@@ -1,6 +1,7 @@
 package clause
 
 import (
+	"errors"
 	"fmt"
 	"github.com/haysons/nebulaorm/resolver"
 	"reflect"
@@ -11,6 +12,7 @@ type UpdateEdge struct {
 	Edge        interface{}
 	PropsUpdate interface{}
 	Opts        Options
+	Resolver    *resolver.Resolver // used to get the current time in the timezone of the server, default resolver if nil
 }
 
 const UpdateEdgeName = "UPDATE_EDGE"
@@ -42,7 +44,14 @@ func (ue UpdateEdge) Build(nGQL Builder) error {
 			if err != nil {
 				return err
 			}
-			edgeStr = edgeSchema.GetTypeName() + " " + edgeIDExpr(edgeSchema, edgeValue)
+			if fieldErrs := edgeSchema.ValidateVID(edgeValue); len(fieldErrs) > 0 {
+				return &resolver.ValidationError{Errors: fieldErrs}
+			}
+			edgeID, err := edgeIDExpr(edgeSchema, edgeValue)
+			if err != nil {
+				return err
+			}
+			edgeStr = edgeSchema.GetTypeName() + " " + edgeID
 		default:
 			return fmt.Errorf("nebulaorm: %w, build update edge clause failed, dest edge must be struct or struct pointer", ErrInvalidClauseParams)
 		}
@@ -52,8 +61,14 @@ func (ue UpdateEdge) Build(nGQL Builder) error {
 	for _, propName := range ue.Opts.propNames {
 		propsName[propName] = true
 	}
-	propsUpdate, err := getPropsUpdateSet(ue.PropsUpdate, propsName)
+	propsUpdate, err := getPropsUpdateSet(ue.PropsUpdate, propsName, ue.Resolver)
+	if err == nil {
+		propsUpdate, err = applyAssignments(propsUpdate, ue.Opts.assignments)
+	}
 	if err != nil {
+		if errors.Is(err, resolver.ErrValidationFailed) {
+			return err
+		}
 		return fmt.Errorf("nebulaorm: %w, build update edge clause failed, %v", ErrInvalidClauseParams, err)
 	}
 	if edgeStr == "" {
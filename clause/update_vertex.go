@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/haysons/nebulaorm/resolver"
 	"reflect"
+	"sort"
 	"strings"
 )
 
@@ -13,6 +14,7 @@ type UpdateVertex struct {
 	VID       interface{}
 	TagUpdate interface{}
 	Opts      Options
+	Resolver  *resolver.Resolver // used to get the current time in the timezone of the server, default resolver if nil
 }
 
 const UpdateVertexName = "UPDATE_VERTEX"
@@ -35,6 +37,11 @@ func (uv UpdateVertex) Build(nGQL Builder) error {
 	if err != nil {
 		return fmt.Errorf("nebulaorm: %w, build update vertex clause failed, %v", ErrInvalidClauseParams, err)
 	}
+	if isEmptyVID(uv.VID) {
+		validationErr := new(resolver.ValidationError)
+		validationErr.Add(&resolver.FieldError{Field: "VID", Rule: resolver.ValidateRuleVID, Message: "vertex id is empty"})
+		return validationErr
+	}
 	// name of the tag to be updated
 	var tagName string
 	tagNamer, ok := uv.TagUpdate.(resolver.VertexTagNamer)
@@ -49,8 +56,14 @@ func (uv UpdateVertex) Build(nGQL Builder) error {
 	for _, propName := range uv.Opts.propNames {
 		propsName[propName] = true
 	}
-	propsUpdate, err := getPropsUpdateSet(uv.TagUpdate, propsName)
+	propsUpdate, err := getPropsUpdateSet(uv.TagUpdate, propsName, uv.Resolver)
+	if err == nil {
+		propsUpdate, err = applyAssignments(propsUpdate, uv.Opts.assignments)
+	}
 	if err != nil {
+		if errors.Is(err, resolver.ErrValidationFailed) {
+			return err
+		}
 		return fmt.Errorf("nebulaorm: %w, build update vertex clause failed, %v", ErrInvalidClauseParams, err)
 	}
 	if vidExpr == "" {
@@ -77,11 +90,44 @@ func (uv UpdateVertex) Build(nGQL Builder) error {
 	return nil
 }
 
-func getPropsUpdateSet(propsUpdate interface{}, needUpdate map[string]bool) ([][2]string, error) {
+// isEmptyVID whether the string vertex id or any of the string vertex ids is empty, or the slice of vertex ids is empty
+func isEmptyVID(vid interface{}) bool {
+	switch id := vid.(type) {
+	case string:
+		return id == ""
+	case []string:
+		for _, v := range id {
+			if v == "" {
+				return true
+			}
+		}
+		return len(id) == 0
+	case []int:
+		return len(id) == 0
+	case []int64:
+		return len(id) == 0
+	}
+	return false
+}
+
+func getPropsUpdateSet(propsUpdate interface{}, needUpdate map[string]bool, rv *resolver.Resolver) ([][2]string, error) {
 	propsUpdateSet := make([][2]string, 0)
 	switch prop := propsUpdate.(type) {
+	case nil:
+		// only the assignments specified by WithSet are updated
+	case Assignment:
+		return applyAssignments(propsUpdateSet, []Assignment{prop})
+	case []Assignment:
+		return applyAssignments(propsUpdateSet, prop)
 	case map[string]interface{}:
-		for k, v := range prop {
+		// the map is traversed in the order of the keys, so that the generated statement is stable
+		keys := make([]string, 0, len(prop))
+		for k := range prop {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			v := prop[k]
 			if len(needUpdate) > 0 && !needUpdate[k] {
 				continue
 			}
@@ -89,6 +135,16 @@ func getPropsUpdateSet(propsUpdate interface{}, needUpdate map[string]bool) ([][
 			var propValue string
 			var err error
 			switch expr := v.(type) {
+			case nil:
+				propValue = "NULL"
+			case Assignment:
+				if expr.Prop != k {
+					return nil, fmt.Errorf("the assignment of prop %s is set to the key %s of the map", expr.Prop, k)
+				}
+				propValue, err = expr.buildValue()
+				if err != nil {
+					return nil, err
+				}
 			case Expr:
 				exprBuilder := new(strings.Builder)
 				err = expr.Build(exprBuilder)
@@ -115,37 +171,52 @@ func getPropsUpdateSet(propsUpdate interface{}, needUpdate map[string]bool) ([][
 		propsValue := reflect.Indirect(reflect.ValueOf(propsUpdate))
 		switch propsValue.Kind() {
 		case reflect.Struct:
-			propsType := propsValue.Type()
-			for i := 0; i < propsType.NumField(); i++ {
-				structField := propsType.Field(i)
-				if structField.Anonymous || !structField.IsExported() {
+			props, err := resolver.ParseStructProps(propsValue.Type())
+			if err != nil {
+				return nil, err
+			}
+			now := rv.Now()
+			validationErr := new(resolver.ValidationError)
+			for i, prop := range props {
+				if prop == nil {
 					continue
 				}
-				propName := resolver.GetPropName(structField)
-				nebulaType := resolver.GetValueNebulaType(structField)
+				propName := prop.Name
 				fieldValue := propsValue.Field(i)
 				if len(needUpdate) > 0 && needUpdate[propName] {
-					propValue, err := resolver.FormatSimpleValue(nebulaType, fieldValue)
+					// the zero auto update time property is filled with the current time even if it is specified
+					if prop.AutoUpdateTime != resolver.AutoTimeNone && fieldValue.IsZero() {
+						fieldValue = prop.AutoTimeValue(now, prop.AutoUpdateTime)
+					}
+					validationErr.Add(prop.Validate(fieldValue))
+					propValue, err := prop.FormatValue(fieldValue)
 					if err != nil {
 						return nil, err
 					}
 					propsUpdateSet = append(propsUpdateSet, [2]string{propName, propValue})
-				} else if len(needUpdate) > 0 {
 					continue
-				} else {
+				}
+				setting := prop.Setting
+				if setting[resolver.TagSettingIgnore] != "" || setting[resolver.TagSettingEdgeSrcID] != "" || setting[resolver.TagSettingEdgeDstID] != "" || setting[resolver.TagSettingEdgeRank] != "" || setting[resolver.TagSettingVertexID] != "" {
+					continue
+				}
+				// the auto update time property is always updated, the current time is used if the value is not specified
+				if prop.AutoUpdateTime != resolver.AutoTimeNone {
 					if fieldValue.IsZero() {
-						continue
-					}
-					setting := resolver.ParseTagSetting(structField.Tag.Get(resolver.TagSettingKey))
-					if setting[resolver.TagSettingIgnore] != "" || setting[resolver.TagSettingEdgeSrcID] != "" || setting[resolver.TagSettingEdgeDstID] != "" || setting[resolver.TagSettingEdgeRank] != "" || setting[resolver.TagSettingVertexID] != "" {
-						continue
-					}
-					propValue, err := resolver.FormatSimpleValue(nebulaType, fieldValue)
-					if err != nil {
-						return nil, err
+						fieldValue = prop.AutoTimeValue(now, prop.AutoUpdateTime)
 					}
-					propsUpdateSet = append(propsUpdateSet, [2]string{propName, propValue})
+				} else if len(needUpdate) > 0 || fieldValue.IsZero() {
+					continue
 				}
+				validationErr.Add(prop.Validate(fieldValue))
+				propValue, err := prop.FormatValue(fieldValue)
+				if err != nil {
+					return nil, err
+				}
+				propsUpdateSet = append(propsUpdateSet, [2]string{propName, propValue})
+			}
+			if err := validationErr.Err(); err != nil {
+				return nil, err
 			}
 		case reflect.Map:
 			propsType := propsValue.Type()
@@ -159,7 +230,7 @@ func getPropsUpdateSet(propsUpdate interface{}, needUpdate map[string]bool) ([][
 				v := mapIter.Value().Interface()
 				updateMap[k] = v
 			}
-			return getPropsUpdateSet(updateMap, needUpdate)
+			return getPropsUpdateSet(updateMap, needUpdate, rv)
 		default:
 			return nil, errors.New("update values must be map[string]interface{}, struct or struct pointer")
 		}
@@ -3,6 +3,7 @@ package clause_test
 import (
 	"fmt"
 	"github.com/haysons/nebulaorm/clause"
+	"github.com/haysons/nebulaorm/resolver"
 	"testing"
 )
 
@@ -22,12 +23,16 @@ func TestUpdateVertex(t *testing.T) {
 		},
 		{
 			clauses: []clause.Interface{clause.UpdateVertex{IsUpsert: true, VID: "player668", TagUpdate: playerUpdate{"name": "Amber", "age": &clause.Expr{Str: "age + 1"}}}},
-			gqlWant: `UPSERT VERTEX ON player "player668" SET name = "Amber", age = age + 1`,
+			gqlWant: `UPSERT VERTEX ON player "player668" SET age = age + 1, name = "Amber"`,
 		},
 		{
 			clauses: []clause.Interface{clause.UpdateVertex{VID: 101, TagUpdate: &playerTag{Name: "hayson", Age: 26}}},
 			gqlWant: `UPDATE VERTEX ON player 101 SET name = "hayson", age = 26`,
 		},
+		{
+			clauses: []clause.Interface{clause.UpdateVertex{VID: "t51", TagUpdate: &t5{Conf: map[string]int{"b": 2}}}},
+			gqlWant: `UPDATE VERTEX ON t5 "t51" SET conf = "{\"b\":2}"`,
+		},
 		{
 			clauses: []clause.Interface{clause.UpdateVertex{}},
 			errWant: clause.ErrInvalidClauseParams,
@@ -36,6 +41,22 @@ func TestUpdateVertex(t *testing.T) {
 			clauses: []clause.Interface{clause.UpdateVertex{VID: 101}},
 			errWant: clause.ErrInvalidClauseParams,
 		},
+		{
+			clauses: []clause.Interface{clause.UpdateVertex{VID: "", TagUpdate: &playerTag{Name: "hayson"}}},
+			errWant: resolver.ErrValidationFailed,
+		},
+		{
+			clauses: []clause.Interface{clause.UpdateVertex{IsUpsert: true, VID: "", TagUpdate: &playerTag{Name: "hayson"}}},
+			errWant: resolver.ErrValidationFailed,
+		},
+		{
+			clauses: []clause.Interface{clause.UpdateVertex{VID: []string{"player100", ""}, TagUpdate: &playerTag{Name: "hayson"}}},
+			errWant: resolver.ErrValidationFailed,
+		},
+		{
+			clauses: []clause.Interface{clause.UpdateVertex{IsUpsert: true, VID: []string{}, TagUpdate: &playerTag{Name: "hayson"}}},
+			errWant: resolver.ErrValidationFailed,
+		},
 	}
 	for i, tt := range tests {
 		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
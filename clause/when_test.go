@@ -13,6 +13,12 @@ func TestWhen(t *testing.T) {
 		gqlWant string
 		errWant error
 	}{
+		{
+			clauses: []clause.Interface{
+				clause.When{Conditions: []clause.Condition{{Expr: clause.And(clause.Gt{Prop: "age", Value: 30}, clause.Or(clause.Eq{Prop: "name", Value: "Tim"}, clause.IsNull{Prop: "name"}))}}},
+			},
+			gqlWant: `WHEN age > 30 AND (name == "Tim" OR name IS NULL)`,
+		},
 		{
 			clauses: []clause.Interface{
 				clause.When{Conditions: []clause.Condition{{Operator: "AND", Expr: clause.Expr{Str: "v.player.name == ?", Vars: []interface{}{"Tim Duncan"}}}}},
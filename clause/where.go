@@ -1,14 +1,16 @@
 package clause
 
-import "strings"
+import "fmt"
 
 type Where struct {
 	Conditions []Condition
 }
 
+// Condition the condition joined to the previous one by the operator, the Expr is a raw expression such as
+// Expr{Str: "player.age > ?", Vars: []interface{}{30}}, or a structured condition such as Gt{Prop: "player.age", Value: 30}
 type Condition struct {
 	Operator string
-	Expr     Expr
+	Expr     Expression
 }
 
 const (
@@ -40,21 +42,20 @@ func (where Where) Build(nGQL Builder) error {
 
 func buildConditions(conditions []Condition, nGQL Builder) error {
 	for i, expr := range conditions {
+		if expr.Expr == nil {
+			return fmt.Errorf("nebulaorm: %w, the condition is nil", ErrInvalidClauseParams)
+		}
 		if i > 0 {
 			nGQL.WriteString(expr.Operator)
 			nGQL.WriteByte(' ')
 		}
-		gql := strings.ToUpper(expr.Expr.Str)
-		if strings.Contains(gql, " AND ") || strings.Contains(gql, " OR ") || strings.Contains(gql, " NOT ") || strings.Contains(gql, " XOR ") {
-			nGQL.WriteByte('(')
-			if err := expr.Expr.Build(nGQL); err != nil {
-				return err
-			}
-			nGQL.WriteByte(')')
-		} else {
+		// a single condition needs no parentheses
+		if len(conditions) == 1 {
 			if err := expr.Expr.Build(nGQL); err != nil {
 				return err
 			}
+		} else if err := buildNestedCondition(nGQL, expr.Expr); err != nil {
+			return err
 		}
 		if i < len(conditions)-1 {
 			nGQL.WriteByte(' ')
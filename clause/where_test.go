@@ -77,6 +77,20 @@ func TestWhere(t *testing.T) {
 			},
 			gqlWant: `WHERE v.date1.p3 < datetime("1988-03-18T00:00:00")`,
 		},
+		{
+			clauses: []clause.Interface{
+				clause.Where{Conditions: []clause.Condition{{Expr: clause.Expr{Str: "player.name == ?", Vars: []interface{}{"Tom AND Jerry"}}}}},
+				clause.Where{Conditions: []clause.Condition{{Operator: clause.OperatorOr, Expr: clause.Expr{Str: "player.name == 'Tom OR Jerry'"}}}},
+			},
+			gqlWant: `WHERE player.name == "Tom AND Jerry" OR player.name == 'Tom OR Jerry'`,
+		},
+		{
+			clauses: []clause.Interface{
+				clause.Where{Conditions: []clause.Condition{{Expr: clause.Or(clause.Eq{Prop: "player.name", Value: "Tim"}, clause.Gt{Prop: "player.age", Value: 30})}}},
+				clause.Where{Conditions: []clause.Condition{{Operator: clause.OperatorAnd, Expr: clause.IsNotNull{Prop: "player.age"}}}},
+			},
+			gqlWant: `WHERE (player.name == "Tim" OR player.age > 30) AND player.age IS NOT NULL`,
+		},
 	}
 	for i, tt := range tests {
 		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
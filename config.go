@@ -32,15 +32,75 @@ type Config struct {
 	MinOpenConns int `json:"min_open_conns" yaml:"min_open_conns"`
 
 	// TimezoneName time zone name, default is Local, if the nebula graph server is configured in a time zone different from Local,
-	// you need to change it to the same configuration as the nebula graph server.
+	// you need to change it to the same configuration as the nebula graph server. The timezone is owned by each DB, so DBs
+	// connected to servers in different timezones can be used in the same process.
 	TimezoneName string `json:"timezone_name" yaml:"timezone_name"`
 
+	// Clusters additional nebula graph clusters, such as read replicas or an analytics cluster, the cluster configured
+	// above is the primary cluster, all write statements are executed on the primary cluster
+	Clusters []ClusterConfig `json:"clusters" yaml:"clusters"`
+
+	// ReadPolicy decides which cluster the read statements are executed on, default is ReadPolicyPrimary
+	ReadPolicy string `json:"read_policy" yaml:"read_policy"`
+
+	// PoolType the pool that the statements are executed through, default is PoolTypeSession, PoolTypeConnection is
+	// required by db.Session, and the SpaceName is optional with it
+	PoolType string `json:"pool_type" yaml:"pool_type"`
+
+	// DryRun build the statements without executing them, no connection is created, the terminal methods such as Find
+	// and Exec return empty results, use db.ToNGQL to get the statements. the before hooks of the models are still
+	// called, since they may change the statements, but the after hooks and AfterFind are not called.
+	DryRun bool `json:"dry_run" yaml:"dry_run"`
+
 	// nebulaSessionOpts nebula session pool config
 	nebulaSessionOpts []nebula.SessionPoolConfOption
 
+	// clusterExecutors the executors of the clusters given to OpenWithExecutor
+	clusterExecutors map[string]Executor
+
 	timezone *time.Location
 }
 
+const (
+	// ReadPolicyPrimary read statements are executed on the primary cluster
+	ReadPolicyPrimary = "primary"
+
+	// ReadPolicyReplica read statements are executed on the replica clusters in turn, and on the primary cluster if
+	// there is no replica cluster
+	ReadPolicyReplica = "replica"
+)
+
+const (
+	// PoolTypeSession the statements are executed through nebula.SessionPool, which requires a fixed space and user
+	PoolTypeSession = "session"
+
+	// PoolTypeConnection the statements are executed through nebula.ConnectionPool, sessions are created on demand and
+	// can be pinned through db.Session for session-scoped settings such as variables and USE
+	PoolTypeConnection = "connection"
+)
+
+// ClusterConfig config of an additional nebula graph cluster
+type ClusterConfig struct {
+	// Name of the cluster, which is used to specify the cluster of the statement through db.Cluster
+	Name string `json:"name" yaml:"name"`
+
+	// Addresses server address list of the cluster，host:port
+	Addresses []string `json:"addresses" yaml:"addresses"`
+
+	// Username default is the username of the primary cluster
+	Username string `json:"username" yaml:"username"`
+
+	// Password default is the password of the primary cluster
+	Password string `json:"password" yaml:"password"`
+
+	// SpaceName default is the space name of the primary cluster
+	SpaceName string `json:"space_name" yaml:"space_name"`
+
+	// Replica whether the read statements can be routed to the cluster according to ReadPolicy, the cluster that is not a
+	// replica is used only when it is specified through db.Cluster, such as an analytics cluster
+	Replica bool `json:"replica" yaml:"replica"`
+}
+
 type ConfigOption interface {
 	apply(*Config)
 }
@@ -57,3 +117,14 @@ func WithNebulaSessionPoolOptions(opts []nebula.SessionPoolConfOption) ConfigOpt
 		config.nebulaSessionOpts = append(config.nebulaSessionOpts, opts...)
 	})
 }
+
+// WithClusterExecutor the executor of the cluster in Config.Clusters, it is only used by OpenWithExecutor, such as
+// replacing each cluster with a fake executor in tests
+func WithClusterExecutor(name string, executor Executor) ConfigOption {
+	return funcConfigOption(func(config *Config) {
+		if config.clusterExecutors == nil {
+			config.clusterExecutors = make(map[string]Executor)
+		}
+		config.clusterExecutors[name] = executor
+	})
+}
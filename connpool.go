@@ -0,0 +1,197 @@
+package nebulaorm
+
+import (
+	"errors"
+	"fmt"
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+	"sync"
+)
+
+// connectionPool is the executor built on nebula.ConnectionPool, it is used when Config.PoolType is PoolTypeConnection.
+// Unlike nebula.SessionPool, the space is optional, and a session can be pinned through db.Session to keep the
+// session-scoped settings between statements. The idle sessions are reused, a session whose space is changed by the
+// statement is switched back to the space of the pool before it is reused.
+type connectionPool struct {
+	pool      *nebula.ConnectionPool
+	username  string
+	password  string
+	spaceName string
+
+	mu      sync.Mutex
+	idle    []*nebula.Session
+	maxIdle int
+	closed  bool
+}
+
+var (
+	_ Executor      = (*connectionPool)(nil)
+	_ SessionPinner = (*connectionPool)(nil)
+	_ SessionPinner = (*pinnedSession)(nil)
+)
+
+func newConnectionPool(conf *Config, addresses []string, username, password, spaceName string) (*connectionPool, error) {
+	hostAddr, err := parseServerAddr(addresses)
+	if err != nil {
+		return nil, err
+	}
+	poolConf := nebula.GetDefaultConf()
+	if conf.MaxOpenConns > 0 {
+		poolConf.MaxConnPoolSize = conf.MaxOpenConns
+	}
+	if conf.MinOpenConns > 0 {
+		poolConf.MinConnPoolSize = conf.MinOpenConns
+	}
+	if conf.ConnTimeout > 0 {
+		poolConf.TimeOut = conf.ConnTimeout
+	}
+	if conf.ConnMaxIdleTime > 0 {
+		poolConf.IdleTime = conf.ConnMaxIdleTime
+	}
+	pool, err := nebula.NewConnectionPool(hostAddr, poolConf, nebula.DefaultLogger{})
+	if err != nil {
+		return nil, fmt.Errorf("nebulaorm: create connection pool failed: %v", err)
+	}
+	return &connectionPool{
+		pool:      pool,
+		username:  username,
+		password:  password,
+		spaceName: spaceName,
+		maxIdle:   poolConf.MaxConnPoolSize,
+	}, nil
+}
+
+// Execute the statement on an idle session
+func (p *connectionPool) Execute(stmt string) (*nebula.ResultSet, error) {
+	return p.ExecuteWithParameter(stmt, map[string]interface{}{})
+}
+
+// ExecuteWithParameter execute the statement with parameters on an idle session, if the session is expired on the
+// server, the statement is retried once on a new session
+func (p *connectionPool) ExecuteWithParameter(stmt string, params map[string]interface{}) (*nebula.ResultSet, error) {
+	for retried := false; ; retried = true {
+		session, err := p.acquire()
+		if err != nil {
+			return nil, err
+		}
+		res, err := session.ExecuteWithParameter(stmt, params)
+		if err != nil {
+			session.Release()
+			return nil, err
+		}
+		if isSessionExpired(res) && !retried {
+			session.Release()
+			continue
+		}
+		p.put(session, res.GetSpaceName())
+		return res, nil
+	}
+}
+
+// Close release all the idle sessions and close the connection pool
+func (p *connectionPool) Close() {
+	p.mu.Lock()
+	idle := p.idle
+	p.idle, p.closed = nil, true
+	p.mu.Unlock()
+	for _, session := range idle {
+		session.Release()
+	}
+	p.pool.Close()
+}
+
+// PinSession acquire a session of the pool, the session is released instead of being reused
+func (p *connectionPool) PinSession() (Executor, func(), error) {
+	session, err := p.acquire()
+	if err != nil {
+		return nil, nil, err
+	}
+	// the session-scoped settings may be changed, so the pinned session is released instead of being reused
+	return &pinnedSession{session: session}, session.Release, nil
+}
+
+// acquire get an idle session, or create a new one if there is no idle session
+func (p *connectionPool) acquire() (*nebula.Session, error) {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil, errors.New("nebulaorm: connection pool is closed")
+	}
+	if n := len(p.idle); n > 0 {
+		session := p.idle[n-1]
+		p.idle = p.idle[:n-1]
+		p.mu.Unlock()
+		return session, nil
+	}
+	p.mu.Unlock()
+	return p.newSession()
+}
+
+func (p *connectionPool) newSession() (*nebula.Session, error) {
+	session, err := p.pool.GetSession(p.username, p.password)
+	if err != nil {
+		return nil, fmt.Errorf("nebulaorm: get session failed: %v", err)
+	}
+	if p.spaceName == "" {
+		return session, nil
+	}
+	if err = p.useSpace(session); err != nil {
+		session.Release()
+		return nil, err
+	}
+	return session, nil
+}
+
+func (p *connectionPool) useSpace(session *nebula.Session) error {
+	res, err := session.Execute("USE " + p.spaceName + ";")
+	if err != nil {
+		return fmt.Errorf("nebulaorm: use space %s failed: %v", p.spaceName, err)
+	}
+	if !res.IsSucceed() {
+		return fmt.Errorf("nebulaorm: use space %s failed, err code: %d, msg: %s", p.spaceName, res.GetErrorCode(), res.GetErrorMsg())
+	}
+	return nil
+}
+
+// put the session back to the idle list, the session is released if it can not be switched back to the space of
+// the pool or there are too many idle sessions
+func (p *connectionPool) put(session *nebula.Session, spaceName string) {
+	if spaceName != p.spaceName && (p.spaceName == "" || p.useSpace(session) != nil) {
+		session.Release()
+		return
+	}
+	p.mu.Lock()
+	if p.closed || len(p.idle) >= p.maxIdle {
+		p.mu.Unlock()
+		session.Release()
+		return
+	}
+	p.idle = append(p.idle, session)
+	p.mu.Unlock()
+}
+
+func isSessionExpired(res *nebula.ResultSet) bool {
+	code := res.GetErrorCode()
+	return code == nebula.ErrorCode_E_SESSION_INVALID || code == nebula.ErrorCode_E_SESSION_TIMEOUT
+}
+
+// pinnedSession executes all the statements on the same session, the broken connection of the session is
+// reconnected by nebula-go, but the expired session is not replaced, since the session-scoped settings would be lost
+type pinnedSession struct {
+	session *nebula.Session
+}
+
+func (s *pinnedSession) Execute(stmt string) (*nebula.ResultSet, error) {
+	return s.session.Execute(stmt)
+}
+
+func (s *pinnedSession) ExecuteWithParameter(stmt string, params map[string]interface{}) (*nebula.ResultSet, error) {
+	return s.session.ExecuteWithParameter(stmt, params)
+}
+
+// Close the session is released by the pinner, nothing to do here
+func (s *pinnedSession) Close() {}
+
+// PinSession the session is already pinned, so the nested pin reuses it and the release is left to the outermost one
+func (s *pinnedSession) PinSession() (Executor, func(), error) {
+	return s, func() {}, nil
+}
@@ -0,0 +1,57 @@
+package nebulaorm
+
+import (
+	"github.com/haysons/nebulaorm/statement"
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+	nebulatype "github.com/vesoft-inc/nebula-go/v3/nebula"
+	"github.com/vesoft-inc/nebula-go/v3/nebula/graph"
+	"strings"
+	"sync"
+)
+
+// recorder records the statements that would be executed in dry run mode
+type recorder struct {
+	mu    sync.Mutex
+	nGQLs []string
+}
+
+func (r *recorder) record(nGQL string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nGQLs = append(r.nGQLs, nGQL)
+}
+
+// ToNGQL run fn in dry run mode and return the statements that would be executed by the terminal methods called in fn,
+// multiple statements are separated by newlines. The statements are not executed even if db is connected. the USE
+// statement added by db.Space and the model space is included, the before hooks are called but the after hooks are not.
+//
+//	nGQL, err := db.ToNGQL(func(tx *nebulaorm.DB) error {
+//		var names []string
+//		return tx.Go().From("player102").Over("serve").Yield("properties(edge).start_year AS start_year").FindCol("start_year", &names)
+//	})
+//
+// GO FROM "player102" OVER serve YIELD properties(edge).start_year AS start_year;
+func (db *DB) ToNGQL(fn func(tx *DB) error) (string, error) {
+	rec := new(recorder)
+	tx := &DB{
+		conf:     db.conf,
+		router:   db.router,
+		resolver: db.resolver,
+		dryRun:   true,
+		recorder: rec,
+		clone:    1,
+	}
+	tx.Statement = statement.New(statement.WithResolver(db.resolver))
+	if err := fn(tx); err != nil {
+		return "", err
+	}
+	return strings.Join(rec.nGQLs, "\n"), nil
+}
+
+// dryRunResult record the statement and return an empty succeeded result
+func (db *DB) dryRunResult(nGQL string) (*nebula.ResultSet, error) {
+	if db.recorder != nil {
+		db.recorder.record(nGQL)
+	}
+	return nebula.GenResultSet(&graph.ExecutionResponse{ErrorCode: nebulatype.ErrorCode_SUCCEEDED})
+}
@@ -16,6 +16,14 @@ var (
 	// ErrValueCannotSet usually because the variable is not passed in as a pointer and cannot be assigned a value
 	ErrValueCannotSet = resolver.ErrValueCannotSet
 
+	// ErrValidationFailed the data to be written does not satisfy the constraints declared in the struct tag, the details
+	// of each field can be obtained through errors.As with *resolver.ValidationError
+	ErrValidationFailed = resolver.ErrValidationFailed
+
 	// ErrInvalidClauseParams usually because the arguments to the build clause are anomalous, causing the build to fail
 	ErrInvalidClauseParams = clause.ErrInvalidClauseParams
+
+	// ErrMissingWhereClause deleting the vertices or edges matched by a query without where clause is rejected, unless
+	// AllowGlobalDelete is called
+	ErrMissingWhereClause = clause.ErrMissingWhereClause
 )
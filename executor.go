@@ -0,0 +1,21 @@
+package nebulaorm
+
+import nebula "github.com/vesoft-inc/nebula-go/v3"
+
+// Executor executes nGQL statements and returns the results of nebula-go, *nebula.SessionPool is the default executor.
+// a custom executor can be used through OpenWithExecutor, such as the fake executor in package nebulaormtest.
+type Executor interface {
+	Execute(stmt string) (*nebula.ResultSet, error)
+	ExecuteWithParameter(stmt string, params map[string]interface{}) (*nebula.ResultSet, error)
+	Close()
+}
+
+var _ Executor = (*nebula.SessionPool)(nil)
+
+// SessionPinner is implemented by the executors that are able to execute statements on a fixed session, it is required
+// by db.Session
+type SessionPinner interface {
+	// PinSession get an executor which executes all the statements on the same session, the release function must be
+	// called when the executor is no longer used
+	PinSession() (Executor, func(), error)
+}
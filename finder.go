@@ -0,0 +1,128 @@
+package nebulaorm
+
+import (
+	"fmt"
+	"github.com/haysons/nebulaorm/clause"
+	"github.com/haysons/nebulaorm/resolver"
+	"reflect"
+)
+
+// FindVertex fetch the vertex by vid into the dest, which is a pointer to a vertex struct, all the tags of the vertex
+// struct are fetched, ErrRecordNotFound is returned if the vertex does not exist.
+//
+// FETCH PROP ON player, team "player100" YIELD vertex AS v
+// db.FindVertex(&player, "player100")
+func (db *DB) FindVertex(dest interface{}, vid interface{}) error {
+	destValue := reflect.ValueOf(dest)
+	if destValue.Kind() != reflect.Ptr || destValue.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("nebulaorm: %w, the dest of FindVertex must be a pointer to a vertex struct", ErrInvalidValue)
+	}
+	tagNames, err := vertexTagNames(destValue.Type())
+	if err != nil {
+		return err
+	}
+	tx := db.getInstance()
+	tx.Statement.FetchMulti(tagNames, vid).Yield("vertex AS v")
+	return tx.TakeCol("v", dest)
+}
+
+// FindVertices fetch the vertices by vids into the dest, which is a pointer to a slice of vertex struct, all the tags
+// of the vertex struct are fetched. If some of the vertices do not exist, the found vertices are still assigned to the
+// dest, and ErrRecordNotFound is returned with the missing vids.
+//
+// FETCH PROP ON player "player100", "player101" YIELD vertex AS v
+// db.FindVertices(&players, []string{"player100", "player101"})
+func (db *DB) FindVertices(dest interface{}, vids interface{}) error {
+	destType := reflect.TypeOf(dest)
+	if destType == nil || destType.Kind() != reflect.Ptr || destType.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("nebulaorm: %w, the dest of FindVertices must be a pointer to a slice of vertex struct", ErrInvalidValue)
+	}
+	vertexType := destType.Elem().Elem()
+	tagNames, err := vertexTagNames(vertexType)
+	if err != nil {
+		return err
+	}
+	tx := db.getInstance()
+	tx.Statement.FetchMulti(tagNames, vids).Yield("vertex AS v")
+	if err = tx.FindCol("v", dest); err != nil {
+		return err
+	}
+	if tx.dryRun {
+		return nil
+	}
+	vertexSchema, err := resolver.ParseVertex(vertexType)
+	if err != nil {
+		return err
+	}
+	found := make(map[string]bool)
+	destValue := reflect.ValueOf(dest).Elem()
+	for i := 0; i < destValue.Len(); i++ {
+		found[fmt.Sprint(vertexSchema.GetVID(reflect.Indirect(destValue.Index(i))))] = true
+	}
+	var missing []interface{}
+	for _, vid := range vidList(vids) {
+		if !found[fmt.Sprint(vid)] {
+			missing = append(missing, vid)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("nebulaorm: %w, vertices %v do not exist", ErrRecordNotFound, missing)
+	}
+	return nil
+}
+
+// FindEdge fetch the properties of the edge by the key of the dest, which is a pointer to an edge struct whose src_id,
+// dst_id and rank are specified, ErrRecordNotFound is returned if the edge does not exist.
+//
+// FETCH PROP ON serve "player100"->"team204"@1 YIELD edge AS e
+// db.FindEdge(&serve{SrcID: "player100", DstID: "team204", Rank: 1})
+func (db *DB) FindEdge(dest interface{}) error {
+	destValue := reflect.ValueOf(dest)
+	if destValue.Kind() != reflect.Ptr || destValue.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("nebulaorm: %w, the dest of FindEdge must be a pointer to an edge struct", ErrInvalidValue)
+	}
+	edgeSchema, err := resolver.ParseEdge(destValue.Type())
+	if err != nil {
+		return err
+	}
+	if edgeSchema.GetSrcVIDExpr(destValue) == "" || edgeSchema.GetDstVIDExpr(destValue) == "" {
+		return fmt.Errorf("nebulaorm: %w, the src_id and dst_id of the edge are required by FindEdge", ErrInvalidValue)
+	}
+	tx := db.getInstance()
+	tx.Statement.FetchEdge(edgeSchema.GetTypeName(), dest).Yield("edge AS e")
+	return tx.TakeCol("e", dest)
+}
+
+// vertexTagNames get all the tag names of the vertex struct
+func vertexTagNames(vertexType reflect.Type) ([]string, error) {
+	for vertexType.Kind() == reflect.Ptr {
+		vertexType = vertexType.Elem()
+	}
+	vertexSchema, err := resolver.ParseVertex(vertexType)
+	if err != nil {
+		return nil, err
+	}
+	tags := vertexSchema.GetTags()
+	tagNames := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tagNames = append(tagNames, tag.TagName)
+	}
+	return tagNames, nil
+}
+
+// vidList get the list of the vids, the expression such as $-.id is not a vid and is skipped
+func vidList(vids interface{}) []interface{} {
+	switch vids.(type) {
+	case clause.Expr, *clause.Expr, []clause.Expr, []*clause.Expr:
+		return nil
+	}
+	vidsValue := reflect.ValueOf(vids)
+	if vidsValue.Kind() != reflect.Slice && vidsValue.Kind() != reflect.Array {
+		return []interface{}{vids}
+	}
+	list := make([]interface{}, 0, vidsValue.Len())
+	for i := 0; i < vidsValue.Len(); i++ {
+		list = append(list, vidsValue.Index(i).Interface())
+	}
+	return list
+}
@@ -0,0 +1,213 @@
+package nebulaorm
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// BeforeInsertHook called before the vertexes or edges passed to InsertVertex and InsertEdge are written,
+// the changes made to the model will be written into nebula graph, returning an error will cancel the insertion.
+//
+// the hooks declared on the pointer receiver are only called for the models passed by pointer or in a slice, the model
+// passed by value, such as InsertVertex(player{}), can not be modified and only calls the hooks on the value receiver.
+type BeforeInsertHook interface {
+	BeforeInsert() error
+}
+
+// AfterInsertHook called after the vertexes or edges passed to InsertVertex and InsertEdge are written successfully
+type AfterInsertHook interface {
+	AfterInsert() error
+}
+
+// BeforeUpdateHook called before the props passed to UpdateVertex, UpsertVertex, UpdateEdge and UpsertEdge are
+// written, returning an error will cancel the update
+type BeforeUpdateHook interface {
+	BeforeUpdate() error
+}
+
+// AfterUpdateHook called after the props passed to UpdateVertex, UpsertVertex, UpdateEdge and UpsertEdge are
+// written successfully
+type AfterUpdateHook interface {
+	AfterUpdate() error
+}
+
+// BeforeDeleteHook called before the vertexes, edges or tags passed to DeleteVertex, DeleteEdge and DeleteTag are
+// deleted, returning an error will cancel the deletion
+type BeforeDeleteHook interface {
+	BeforeDelete() error
+}
+
+// AfterDeleteHook called after the vertexes, edges or tags passed to DeleteVertex, DeleteEdge and DeleteTag are deleted
+// successfully
+type AfterDeleteHook interface {
+	AfterDelete() error
+}
+
+// AfterFindHook called after each struct in dest is scanned by Find, Take, FindCol and TakeCol
+type AfterFindHook interface {
+	AfterFind() error
+}
+
+type hookKind int
+
+const (
+	hookInsert hookKind = iota
+	hookUpdate
+	hookDelete
+)
+
+// hookModel the model passed to the statement, whose hooks are called when the statement is executed
+type hookModel struct {
+	kind  hookKind
+	model interface{}
+}
+
+// SkipHooks the hooks of the models will not be called when executing the current statement
+func (db *DB) SkipHooks() (tx *DB) {
+	tx = db.getInstance()
+	tx.skipHooks = true
+	return
+}
+
+// addModel record the model passed to the statement, the hooks of the model are called around the execution, and the
+// space declared by the model is used if db.Space is not called, even if the hooks are skipped
+func (db *DB) addModel(kind hookKind, model interface{}) {
+	db.hookModels = append(db.hookModels, hookModel{kind: kind, model: model})
+	db.models = append(db.models, model)
+}
+
+// addTagModels record the tag structs passed to DeleteTag as the models, the tag names are skipped
+func (db *DB) addTagModels(tagNames interface{}) {
+	value := reflect.ValueOf(tagNames)
+	switch value.Kind() {
+	case reflect.Invalid, reflect.String:
+		return
+	case reflect.Slice, reflect.Array:
+		switch value.Type().Elem().Kind() {
+		case reflect.String:
+			return
+		case reflect.Interface:
+			for i := 0; i < value.Len(); i++ {
+				db.addTagModels(value.Index(i).Interface())
+			}
+			return
+		}
+	}
+	db.addModel(hookDelete, tagNames)
+}
+
+// callBeforeHooks call the hooks of the models before the statement is built and executed, the hooks are called only
+// once, so that the statement built from the changed models, such as by Save, does not call them again
+func (db *DB) callBeforeHooks() error {
+	if db.skipHooks || db.beforeHooksCalled {
+		return nil
+	}
+	db.beforeHooksCalled = true
+	for _, hm := range db.hookModels {
+		err := walkModels(reflect.ValueOf(hm.model), func(model interface{}) error {
+			switch hm.kind {
+			case hookInsert:
+				if hook, ok := model.(BeforeInsertHook); ok {
+					return hook.BeforeInsert()
+				}
+			case hookUpdate:
+				if hook, ok := model.(BeforeUpdateHook); ok {
+					return hook.BeforeUpdate()
+				}
+			case hookDelete:
+				if hook, ok := model.(BeforeDeleteHook); ok {
+					return hook.BeforeDelete()
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("nebulaorm: call before hook failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// callAfterHooks call the hooks of the models after the statement is executed successfully, the statement is not
+// executed in dry run mode, so the after hooks are not called
+func (db *DB) callAfterHooks() error {
+	if db.skipHooks {
+		return nil
+	}
+	for _, hm := range db.hookModels {
+		err := walkModels(reflect.ValueOf(hm.model), func(model interface{}) error {
+			switch hm.kind {
+			case hookInsert:
+				if hook, ok := model.(AfterInsertHook); ok {
+					return hook.AfterInsert()
+				}
+			case hookUpdate:
+				if hook, ok := model.(AfterUpdateHook); ok {
+					return hook.AfterUpdate()
+				}
+			case hookDelete:
+				if hook, ok := model.(AfterDeleteHook); ok {
+					return hook.AfterDelete()
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("nebulaorm: call after hook failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// callAfterFind call AfterFind of each struct in dest, nothing is found in dry run mode, so it is not called
+func (db *DB) callAfterFind(dest interface{}) error {
+	if db.skipHooks || db.dryRun {
+		return nil
+	}
+	err := walkModels(reflect.ValueOf(dest), func(model interface{}) error {
+		if hook, ok := model.(AfterFindHook); ok {
+			return hook.AfterFind()
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("nebulaorm: call after find hook failed: %w", err)
+	}
+	return nil
+}
+
+// walkModels call fn with each struct in value, the pointer of the struct is used if it is addressable, so that the
+// hooks declared on the pointer receiver can be called and the changes made by the hooks can be preserved. the struct
+// passed by value, such as InsertVertex(player{}), is not addressable, so only the hooks declared on the value receiver
+// are called for it.
+func walkModels(value reflect.Value, fn func(model interface{}) error) error {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			return nil
+		}
+		if value.Elem().Kind() == reflect.Struct {
+			return fn(value.Interface())
+		}
+		return walkModels(value.Elem(), fn)
+	case reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		return walkModels(value.Elem(), fn)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if err := walkModels(value.Index(i), fn); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		if value.CanAddr() {
+			return fn(value.Addr().Interface())
+		}
+		if value.CanInterface() {
+			return fn(value.Interface())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package nebulaormtest_test
+
+import (
+	"github.com/haysons/nebulaorm"
+	"github.com/haysons/nebulaorm/clause"
+	"github.com/haysons/nebulaorm/nebulaormtest"
+	"reflect"
+	"testing"
+)
+
+func TestToNGQL(t *testing.T) {
+	db, err := nebulaorm.New()
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	nGQL, err := db.ToNGQL(func(tx *nebulaorm.DB) error {
+		if err := tx.Space("basketball").InsertVertex(&player{VID: "player100", Name: "Tim Duncan", Age: 42}).Exec(); err != nil {
+			return err
+		}
+		var ids []string
+		return tx.Go().From("player100").Over("follow").Yield("dst(edge) AS id").FindCol("id", &ids)
+	})
+	if err != nil {
+		t.Errorf("ToNGQL() error = %v", err)
+	}
+	want := `USE basketball; INSERT VERTEX player(name, age) VALUES "player100":("Tim Duncan", 42);` + "\n" +
+		`GO FROM "player100" OVER follow YIELD dst(edge) AS id;`
+	if nGQL != want {
+		t.Errorf("ToNGQL() got = %v, want %v", nGQL, want)
+	}
+}
+
+func TestDryRunNotExecuted(t *testing.T) {
+	// the executor has no expectation, so any statement reaching it fails
+	exec := nebulaormtest.NewExecutor()
+	db, _ := nebulaorm.OpenWithExecutor(&nebulaorm.Config{DryRun: true}, exec)
+
+	if err := db.InsertVertex(&player{VID: "player100", Name: "Tim Duncan"}).Exec(); err != nil {
+		t.Errorf("Exec() error = %v", err)
+	}
+	var players []player
+	if err := db.Fetch("player", "player100").Yield("vertex AS v").Find(&players); err != nil || len(players) != 0 {
+		t.Errorf("Find() got = %v, error = %v", players, err)
+	}
+	var p player
+	if err := db.Fetch("player", "player100").Yield("properties(vertex).name AS name").Take(&p); err != nil {
+		t.Errorf("Take() error = %v", err)
+	}
+	if _, err := db.Save(&player{VID: "player100", Name: "Tim Duncan"}); err != nil {
+		t.Errorf("Save() error = %v", err)
+	}
+	err := db.UpdateVertex("player100", clause.Incr("age", 1), clause.WithTagName("player")).Returning(&p)
+	if err != nil {
+		t.Errorf("Returning() error = %v", err)
+	}
+
+	nGQL, err := db.ToNGQL(func(tx *nebulaorm.DB) error {
+		return tx.UpdateVertex("player100", clause.Incr("age", 1), clause.WithTagName("player")).Returning(&p)
+	})
+	if want := `UPDATE VERTEX ON player "player100" SET age = age + 1 YIELD name AS name, age AS age;`; err != nil || nGQL != want {
+		t.Errorf("ToNGQL() got = %v, error = %v, want %v", nGQL, err, want)
+	}
+	if err = exec.ExpectationsWereMet(); err != nil {
+		t.Errorf("ExpectationsWereMet() error = %v", err)
+	}
+}
+
+func TestDryRunHooks(t *testing.T) {
+	db, _ := nebulaorm.New()
+	p := &hookPlayer{VID: "player100", Name: "Tim Duncan"}
+	var found hookPlayer
+	_, err := db.ToNGQL(func(tx *nebulaorm.DB) error {
+		if err := tx.InsertVertex(p).Exec(); err != nil {
+			return err
+		}
+		return tx.Fetch("player", "player100").Yield("properties(vertex).name AS name").Take(&found)
+	})
+	if err != nil {
+		t.Errorf("ToNGQL() error = %v", err)
+	}
+	// the before hooks are called, but the after hooks are not, since nothing is executed
+	if want := []string{"BeforeInsert"}; !reflect.DeepEqual(p.calls, want) {
+		t.Errorf("hooks called = %v, want %v", p.calls, want)
+	}
+	if len(found.calls) != 0 {
+		t.Errorf("hooks called = %v, want none", found.calls)
+	}
+}
@@ -0,0 +1,231 @@
+// Package nebulaormtest provides a scripted fake of nebulaorm.Executor and helpers to construct nebula.ResultSet from
+// golang values, so that the code using nebulaorm can be tested without nebula graph.
+//
+//	exec := nebulaormtest.NewExecutor()
+//	exec.Expect(`FETCH PROP ON player "player100" YIELD properties(vertex).name AS name;`).
+//		WillReturnRows([]string{"name"}, []interface{}{"Tim Duncan"})
+//	db, _ := nebulaorm.OpenWithExecutor(&nebulaorm.Config{SpaceName: "test"}, exec)
+//	var name string
+//	err := db.Fetch("player", "player100").Yield("properties(vertex).name AS name").TakeCol("name", &name)
+//	err = exec.ExpectationsWereMet()
+package nebulaormtest
+
+import (
+	"errors"
+	"fmt"
+	"github.com/haysons/nebulaorm"
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Executor a scripted fake of nebulaorm.Executor, the statements must be executed in the order of the expectations.
+// it also implements nebulaorm.SessionPinner, so that db.Session can be tested, the statements executed on the pinned
+// session can be expected by Expectation.InSession
+type Executor struct {
+	mu           sync.Mutex
+	expectations []*Expectation
+	closed       bool
+	pinned       int
+	released     int
+}
+
+var (
+	_ nebulaorm.Executor      = (*Executor)(nil)
+	_ nebulaorm.SessionPinner = (*Executor)(nil)
+)
+
+func NewExecutor() *Executor {
+	return &Executor{}
+}
+
+// Expect add an expectation that the statement will be executed, the statement must be exactly the same
+func (e *Executor) Expect(nGQL string) *Expectation {
+	x := &Expectation{nGQL: nGQL}
+	e.addExpectation(x)
+	return x
+}
+
+// ExpectRegexp add an expectation that a statement matching the regular expression will be executed
+func (e *Executor) ExpectRegexp(pattern string) *Expectation {
+	x := &Expectation{nGQL: pattern, regexp: regexp.MustCompile(pattern)}
+	e.addExpectation(x)
+	return x
+}
+
+func (e *Executor) addExpectation(x *Expectation) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.expectations = append(e.expectations, x)
+}
+
+func (e *Executor) Execute(stmt string) (*nebula.ResultSet, error) {
+	return e.ExecuteWithParameter(stmt, nil)
+}
+
+// ExecuteWithParameter match the statement with the next expectation and return its result, if the expectation does not
+// specify a result, an empty succeeded result is returned
+func (e *Executor) ExecuteWithParameter(stmt string, params map[string]interface{}) (*nebula.ResultSet, error) {
+	return e.execute(stmt, params, false)
+}
+
+func (e *Executor) execute(stmt string, params map[string]interface{}, inSession bool) (*nebula.ResultSet, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.closed {
+		return nil, errors.New("nebulaormtest: executor has been closed")
+	}
+	var x *Expectation
+	for _, next := range e.expectations {
+		if !next.triggered {
+			x = next
+			break
+		}
+	}
+	if x == nil {
+		return nil, fmt.Errorf("nebulaormtest: unexpected statement %q, all expectations were already fulfilled", stmt)
+	}
+	if !x.match(stmt) {
+		return nil, fmt.Errorf("nebulaormtest: unexpected statement %q, expected %q", stmt, x.nGQL)
+	}
+	if x.params != nil && !reflect.DeepEqual(x.params, params) {
+		return nil, fmt.Errorf("nebulaormtest: statement %q expected params %v, but got %v", stmt, x.params, params)
+	}
+	if x.inSession && !inSession {
+		return nil, fmt.Errorf("nebulaormtest: statement %q expected to be executed on a pinned session", stmt)
+	}
+	x.triggered = true
+	if x.err != nil {
+		return nil, x.err
+	}
+	if x.result != nil {
+		return x.result, nil
+	}
+	return NewResultSet(nil)
+}
+
+func (e *Executor) Close() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.closed = true
+}
+
+// PinSession pin a fake session, the statements executed on it are matched with the same expectations as the executor,
+// the session must be released, otherwise ExpectationsWereMet returns an error
+func (e *Executor) PinSession() (nebulaorm.Executor, func(), error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.closed {
+		return nil, nil, errors.New("nebulaormtest: executor has been closed")
+	}
+	e.pinned++
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			e.mu.Lock()
+			defer e.mu.Unlock()
+			e.released++
+		})
+	}
+	return &session{executor: e}, release, nil
+}
+
+// ExpectationsWereMet return an error if there are expectations that have not been executed or pinned sessions that
+// have not been released
+func (e *Executor) ExpectationsWereMet() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.pinned != e.released {
+		return fmt.Errorf("nebulaormtest: %d of %d pinned sessions were not released", e.pinned-e.released, e.pinned)
+	}
+	var unmet []string
+	for _, x := range e.expectations {
+		if !x.triggered {
+			unmet = append(unmet, x.nGQL)
+		}
+	}
+	if len(unmet) > 0 {
+		return fmt.Errorf("nebulaormtest: there are unfulfilled expectations: %s", strings.Join(unmet, ", "))
+	}
+	return nil
+}
+
+// Expectation the statement expected to be executed and the result returned
+type Expectation struct {
+	nGQL      string
+	regexp    *regexp.Regexp
+	params    map[string]interface{}
+	result    *nebula.ResultSet
+	err       error
+	inSession bool
+	triggered bool
+}
+
+func (x *Expectation) match(stmt string) bool {
+	if x.regexp != nil {
+		return x.regexp.MatchString(stmt)
+	}
+	return x.nGQL == stmt
+}
+
+// InSession the statement is expected to be executed on a pinned session, such as in db.Session
+func (x *Expectation) InSession() *Expectation {
+	x.inSession = true
+	return x
+}
+
+// WithParams the statement is expected to be executed with the params
+func (x *Expectation) WithParams(params map[string]interface{}) *Expectation {
+	x.params = params
+	return x
+}
+
+// WillReturn the result set returned when executing the statement
+func (x *Expectation) WillReturn(rs *nebula.ResultSet) *Expectation {
+	x.result = rs
+	return x
+}
+
+// WillReturnRows the result set constructed by NewResultSet is returned when executing the statement, the error of
+// construction is returned when executing the statement
+func (x *Expectation) WillReturnRows(colNames []string, rows ...[]interface{}) *Expectation {
+	x.result, x.err = NewResultSet(colNames, rows...)
+	return x
+}
+
+// WillReturnError the error returned when executing the statement, such as a network error
+func (x *Expectation) WillReturnError(err error) *Expectation {
+	x.err = err
+	return x
+}
+
+// WillFail the statement is executed but the result is not succeed, such as a syntax error
+func (x *Expectation) WillFail(code nebula.ErrorCode, msg string) *Expectation {
+	x.result, x.err = NewErrorResultSet(code, msg)
+	return x
+}
+
+// session the fake session pinned by Executor.PinSession
+type session struct {
+	executor *Executor
+}
+
+var _ nebulaorm.SessionPinner = (*session)(nil)
+
+func (s *session) Execute(stmt string) (*nebula.ResultSet, error) {
+	return s.executor.execute(stmt, nil, true)
+}
+
+func (s *session) ExecuteWithParameter(stmt string, params map[string]interface{}) (*nebula.ResultSet, error) {
+	return s.executor.execute(stmt, params, true)
+}
+
+// Close the session is released by the release function returned by PinSession, nothing to do here
+func (s *session) Close() {}
+
+// PinSession the session is already pinned, so the nested pin reuses it and the release is left to the outermost one
+func (s *session) PinSession() (nebulaorm.Executor, func(), error) {
+	return s, func() {}, nil
+}
@@ -0,0 +1,298 @@
+package nebulaormtest_test
+
+import (
+	"errors"
+	"github.com/haysons/nebulaorm"
+	"github.com/haysons/nebulaorm/clause"
+	"github.com/haysons/nebulaorm/nebulaormtest"
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+	"testing"
+)
+
+type player struct {
+	VID  string `norm:"vertex_id"`
+	Name string `norm:"prop:name"`
+	Age  int    `norm:"prop:age"`
+}
+
+func (p player) VertexID() string {
+	return p.VID
+}
+
+func (p player) VertexTagName() string {
+	return "player"
+}
+
+func TestExecutor(t *testing.T) {
+	exec := nebulaormtest.NewExecutor()
+	exec.Expect(`INSERT VERTEX player(name, age) VALUES "player100":("Tim Duncan", 42);`)
+	exec.Expect(`GO FROM "player100" OVER follow YIELD dst(edge) AS id, properties($$).age AS age;`).
+		WillReturnRows([]string{"id", "age"}, []interface{}{"player101", 36}, []interface{}{"player125", 41})
+	exec.ExpectRegexp(`^FETCH PROP ON player "player100"`).WillFail(nebula.ErrorCode_E_SEMANTIC_ERROR, "semantic error")
+	db, err := nebulaorm.OpenWithExecutor(&nebulaorm.Config{SpaceName: "test"}, exec)
+	if err != nil {
+		t.Fatalf("OpenWithExecutor() error = %v", err)
+	}
+
+	if err = db.InsertVertex(&player{VID: "player100", Name: "Tim Duncan", Age: 42}).Exec(); err != nil {
+		t.Errorf("Exec() error = %v", err)
+	}
+	type follow struct {
+		ID  string `norm:"col:id"`
+		Age int    `norm:"col:age"`
+	}
+	var follows []follow
+	err = db.Go().From("player100").Over("follow").Yield("dst(edge) AS id, properties($$).age AS age").Find(&follows)
+	if err != nil {
+		t.Errorf("Find() error = %v", err)
+	}
+	if len(follows) != 2 || follows[0].ID != "player101" || follows[1].Age != 41 {
+		t.Errorf("Find() got = %+v", follows)
+	}
+	var p player
+	if err = db.Fetch("player", "player100").Yield("vertex AS v").TakeCol("v", &p); err == nil {
+		t.Errorf("TakeCol() should return the error of the failed result")
+	}
+	if err = exec.ExpectationsWereMet(); err != nil {
+		t.Errorf("ExpectationsWereMet() error = %v", err)
+	}
+	if err = db.Raw("SHOW TAGS;").Exec(); err == nil {
+		t.Errorf("Exec() should return error for unexpected statement")
+	}
+}
+
+func TestExecutorUnmet(t *testing.T) {
+	exec := nebulaormtest.NewExecutor()
+	netErr := errors.New("network error")
+	exec.Expect(`DELETE VERTEX "player100";`).WillReturnError(netErr)
+	exec.Expect(`DELETE VERTEX "player101";`)
+	db, _ := nebulaorm.OpenWithExecutor(&nebulaorm.Config{}, exec)
+	if err := db.DeleteVertex("player101").Exec(); err == nil {
+		t.Errorf("Exec() should return error for the statement out of order")
+	}
+	if err := db.DeleteVertex("player100").Exec(); !errors.Is(err, netErr) {
+		t.Errorf("Exec() got error %v, want %v", err, netErr)
+	}
+	if err := exec.ExpectationsWereMet(); err == nil {
+		t.Errorf("ExpectationsWereMet() should return error")
+	}
+}
+
+func TestExecutorSession(t *testing.T) {
+	exec := nebulaormtest.NewExecutor()
+	exec.Expect(`USE test;`).InSession()
+	exec.Expect(`$var = GO FROM "player100" OVER follow YIELD dst(edge) AS dst;`).InSession()
+	exec.Expect(`FETCH PROP ON player $var.dst YIELD properties(vertex).name AS name;`).InSession().
+		WillReturnRows([]string{"name"}, []interface{}{"Tony Parker"})
+	exec.Expect(`FETCH PROP ON player "player100" YIELD properties(vertex).name AS name | LIMIT 1;`).
+		WillReturnRows([]string{"name"}, []interface{}{"Tim Duncan"})
+	db, err := nebulaorm.OpenWithExecutor(&nebulaorm.Config{SpaceName: "test"}, exec)
+	if err != nil {
+		t.Fatalf("OpenWithExecutor() error = %v", err)
+	}
+	var names []string
+	err = db.Session(func(s *nebulaorm.Session) error {
+		if err := s.Raw("USE test;").Exec(); err != nil {
+			return err
+		}
+		if err := s.Raw(`$var = GO FROM "player100" OVER follow YIELD dst(edge) AS dst;`).Exec(); err != nil {
+			return err
+		}
+		return s.Fetch("player", clause.Expr{Str: "$var.dst"}).Yield("properties(vertex).name AS name").FindCol("name", &names)
+	})
+	if err != nil {
+		t.Errorf("Session() error = %v", err)
+	}
+	if len(names) != 1 || names[0] != "Tony Parker" {
+		t.Errorf("Session() got = %v", names)
+	}
+	var name string
+	if err = db.Fetch("player", "player100").Yield("properties(vertex).name AS name").TakeCol("name", &name); err != nil {
+		t.Errorf("TakeCol() error = %v", err)
+	}
+	if err = exec.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+
+	// the statement out of the session is not executed on the pinned session
+	exec = nebulaormtest.NewExecutor()
+	exec.Expect(`USE test;`).InSession()
+	db, _ = nebulaorm.OpenWithExecutor(&nebulaorm.Config{SpaceName: "test"}, exec)
+	if err = db.Raw("USE test;").Exec(); err == nil {
+		t.Errorf("Exec() error = nil, want the statement expected in session")
+	}
+
+	// the error returned by fn is returned by Session and the session is still released
+	wantErr := errors.New("rollback")
+	exec = nebulaormtest.NewExecutor()
+	db, _ = nebulaorm.OpenWithExecutor(&nebulaorm.Config{SpaceName: "test"}, exec)
+	err = db.Session(func(s *nebulaorm.Session) error {
+		return s.Session(func(s *nebulaorm.Session) error {
+			return wantErr
+		})
+	})
+	if !errors.Is(err, wantErr) {
+		t.Errorf("Session() error = %v, want %v", err, wantErr)
+	}
+	if err = exec.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+
+	// the executor which can not pin a session
+	db, _ = nebulaorm.OpenWithExecutor(&nebulaorm.Config{SpaceName: "test"}, struct{ nebulaorm.Executor }{exec})
+	err = db.Session(func(s *nebulaorm.Session) error {
+		return nil
+	})
+	if !errors.Is(err, nebulaorm.ErrInvalidValue) {
+		t.Errorf("Session() error = %v, want %v", err, nebulaorm.ErrInvalidValue)
+	}
+}
+
+func TestExecutorFindVertex(t *testing.T) {
+	exec := nebulaormtest.NewExecutor()
+	exec.Expect(`FETCH PROP ON player "player100" YIELD vertex AS v | LIMIT 1;`).
+		WillReturnRows([]string{"v"}, []interface{}{player{VID: "player100", Name: "Tim Duncan", Age: 42}})
+	exec.Expect(`FETCH PROP ON player "player404" YIELD vertex AS v | LIMIT 1;`).
+		WillReturnRows([]string{"v"})
+	exec.Expect(`FETCH PROP ON player "player100", "player404" YIELD vertex AS v;`).
+		WillReturnRows([]string{"v"}, []interface{}{player{VID: "player100", Name: "Tim Duncan", Age: 42}})
+	db, _ := nebulaorm.OpenWithExecutor(&nebulaorm.Config{}, exec)
+
+	var p player
+	if err := db.FindVertex(&p, "player100"); err != nil {
+		t.Errorf("FindVertex() error = %v", err)
+	}
+	if p.VID != "player100" || p.Name != "Tim Duncan" || p.Age != 42 {
+		t.Errorf("FindVertex() got = %+v", p)
+	}
+	if err := db.FindVertex(&p, "player404"); !errors.Is(err, nebulaorm.ErrRecordNotFound) {
+		t.Errorf("FindVertex() error = %v, want %v", err, nebulaorm.ErrRecordNotFound)
+	}
+	var players []*player
+	if err := db.FindVertices(&players, []string{"player100", "player404"}); !errors.Is(err, nebulaorm.ErrRecordNotFound) {
+		t.Errorf("FindVertices() error = %v, want %v", err, nebulaorm.ErrRecordNotFound)
+	}
+	if len(players) != 1 || players[0].VID != "player100" {
+		t.Errorf("FindVertices() got = %+v", players)
+	}
+	if err := db.FindVertex(p, "player100"); !errors.Is(err, nebulaorm.ErrInvalidValue) {
+		t.Errorf("FindVertex() error = %v, want %v", err, nebulaorm.ErrInvalidValue)
+	}
+	if err := exec.ExpectationsWereMet(); err != nil {
+		t.Errorf("ExpectationsWereMet() error = %v", err)
+	}
+}
+
+func TestExecutorFindEdge(t *testing.T) {
+	exec := nebulaormtest.NewExecutor()
+	exec.Expect(`FETCH PROP ON serve "player100"->"team204"@1 YIELD edge AS e | LIMIT 1;`).
+		WillReturnRows([]string{"e"}, []interface{}{serve{SrcID: "player100", DstID: "team204", Rank: 1, StartYear: 1997}})
+	exec.Expect(`FETCH PROP ON serve "player100"->"team404" YIELD edge AS e | LIMIT 1;`).
+		WillReturnRows([]string{"e"})
+	db, _ := nebulaorm.OpenWithExecutor(&nebulaorm.Config{}, exec)
+
+	s := serve{SrcID: "player100", DstID: "team204", Rank: 1}
+	if err := db.FindEdge(&s); err != nil {
+		t.Errorf("FindEdge() error = %v", err)
+	}
+	if s.StartYear != 1997 || s.Rank != 1 {
+		t.Errorf("FindEdge() got = %+v", s)
+	}
+	if err := db.FindEdge(&serve{SrcID: "player100", DstID: "team404"}); !errors.Is(err, nebulaorm.ErrRecordNotFound) {
+		t.Errorf("FindEdge() error = %v, want %v", err, nebulaorm.ErrRecordNotFound)
+	}
+	if err := db.FindEdge(&serve{SrcID: "player100"}); !errors.Is(err, nebulaorm.ErrInvalidValue) {
+		t.Errorf("FindEdge() error = %v, want %v", err, nebulaorm.ErrInvalidValue)
+	}
+	if err := exec.ExpectationsWereMet(); err != nil {
+		t.Errorf("ExpectationsWereMet() error = %v", err)
+	}
+}
+
+type profile struct {
+	Country string `norm:"prop:country"`
+}
+
+func (p *profile) VertexTagName() string {
+	return "profile"
+}
+
+type star struct {
+	VID     string `norm:"vertex_id"`
+	Player  player
+	Profile *profile
+}
+
+func (s star) VertexID() string {
+	return s.VID
+}
+
+func TestExecutorSave(t *testing.T) {
+	exec := nebulaormtest.NewExecutor()
+	exec.Expect(`UPSERT VERTEX ON player "player100" SET name = "Tim Duncan", age = 0; UPSERT VERTEX ON profile "player100" SET country = "US";`)
+	exec.Expect(`UPSERT EDGE ON serve "player100"->"team204"@1 SET start_year = 1997; UPSERT VERTEX ON player "player101" SET name = "Tony Parker", age = 36;`)
+	db, _ := nebulaorm.OpenWithExecutor(&nebulaorm.Config{}, exec)
+
+	n, err := db.Save(&star{VID: "player100", Player: player{Name: "Tim Duncan"}, Profile: &profile{Country: "US"}})
+	if err != nil || n != 2 {
+		t.Errorf("Save() got = %d, error = %v", n, err)
+	}
+	n, err = db.Save([]interface{}{serve{SrcID: "player100", DstID: "team204", Rank: 1, StartYear: 1997}, &player{VID: "player101", Name: "Tony Parker", Age: 36}})
+	if err != nil || n != 2 {
+		t.Errorf("Save() got = %d, error = %v", n, err)
+	}
+	if _, err = db.Save(&player{Name: "Tim Duncan"}); !errors.Is(err, nebulaorm.ErrValidationFailed) {
+		t.Errorf("Save() error = %v, want %v", err, nebulaorm.ErrValidationFailed)
+	}
+	if _, err = db.Save(1); !errors.Is(err, nebulaorm.ErrInvalidValue) {
+		t.Errorf("Save() error = %v, want %v", err, nebulaorm.ErrInvalidValue)
+	}
+	if err = exec.ExpectationsWereMet(); err != nil {
+		t.Errorf("ExpectationsWereMet() error = %v", err)
+	}
+}
+
+func TestExecutorReturning(t *testing.T) {
+	exec := nebulaormtest.NewExecutor()
+	exec.Expect(`UPDATE VERTEX ON player "player100" SET age = age + 1 YIELD name AS name, age AS age;`).
+		WillReturnRows([]string{"name", "age"}, []interface{}{"Tim Duncan", 43})
+	exec.Expect(`UPDATE VERTEX ON player "player404" SET age = age + 1 YIELD name AS name, age AS age;`).
+		WillReturnRows([]string{"name", "age"})
+	db, _ := nebulaorm.OpenWithExecutor(&nebulaorm.Config{}, exec)
+
+	var p player
+	err := db.UpdateVertex("player100", map[string]interface{}{"age": clause.Expr{Str: "age + 1"}}, clause.WithTagName("player")).Returning(&p)
+	if err != nil {
+		t.Errorf("Returning() error = %v", err)
+	}
+	if p.Name != "Tim Duncan" || p.Age != 43 {
+		t.Errorf("Returning() got = %+v", p)
+	}
+	err = db.UpdateVertex("player404", map[string]interface{}{"age": clause.Expr{Str: "age + 1"}}, clause.WithTagName("player")).Returning(&p)
+	if !errors.Is(err, nebulaorm.ErrRecordNotFound) {
+		t.Errorf("Returning() error = %v, want %v", err, nebulaorm.ErrRecordNotFound)
+	}
+	if err = exec.ExpectationsWereMet(); err != nil {
+		t.Errorf("ExpectationsWereMet() error = %v", err)
+	}
+}
+
+func TestExecutorDeleteByQuery(t *testing.T) {
+	exec := nebulaormtest.NewExecutor()
+	exec.Expect(`LOOKUP ON player WHERE player.age > 40 YIELD id(vertex) AS id | DELETE VERTEX $-.id WITH EDGE;`)
+	exec.Expect(`GO FROM "player100" OVER serve YIELD src(edge) AS src, dst(edge) AS dst, rank(edge) AS rank | DELETE EDGE serve $-.src -> $-.dst @ $-.rank;`)
+	db, _ := nebulaorm.OpenWithExecutor(&nebulaorm.Config{}, exec)
+
+	if err := db.Lookup("player").Where("player.age > ?", 40).DeleteVertices(true).Exec(); err != nil {
+		t.Errorf("DeleteVertices() error = %v", err)
+	}
+	if err := db.Go().From("player100").Over("serve").DeleteEdges().Exec(); !errors.Is(err, nebulaorm.ErrMissingWhereClause) {
+		t.Errorf("DeleteEdges() error = %v, want %v", err, nebulaorm.ErrMissingWhereClause)
+	}
+	if err := db.Go().From("player100").Over("serve").AllowGlobalDelete().DeleteEdges().Exec(); err != nil {
+		t.Errorf("DeleteEdges() error = %v", err)
+	}
+	if err := exec.ExpectationsWereMet(); err != nil {
+		t.Errorf("ExpectationsWereMet() error = %v", err)
+	}
+}
@@ -0,0 +1,220 @@
+package nebulaormtest_test
+
+import (
+	"errors"
+	"github.com/haysons/nebulaorm"
+	"github.com/haysons/nebulaorm/nebulaormtest"
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var errNameRequired = errors.New("name is required")
+
+type hookPlayer struct {
+	VID   string `norm:"vertex_id"`
+	Name  string `norm:"prop:name"`
+	calls []string
+}
+
+func (p hookPlayer) VertexID() string {
+	return p.VID
+}
+
+func (p hookPlayer) VertexTagName() string {
+	return "player"
+}
+
+func (p *hookPlayer) BeforeInsert() error {
+	p.calls = append(p.calls, "BeforeInsert")
+	if p.Name == "" {
+		return errNameRequired
+	}
+	return nil
+}
+
+func (p *hookPlayer) AfterInsert() error {
+	p.calls = append(p.calls, "AfterInsert")
+	return nil
+}
+
+// BeforeUpdate the name changed by the hook must be written
+func (p *hookPlayer) BeforeUpdate() error {
+	p.calls = append(p.calls, "BeforeUpdate")
+	p.Name = strings.ToUpper(p.Name)
+	return nil
+}
+
+func (p *hookPlayer) AfterUpdate() error {
+	p.calls = append(p.calls, "AfterUpdate")
+	return nil
+}
+
+func (p *hookPlayer) BeforeDelete() error {
+	p.calls = append(p.calls, "BeforeDelete")
+	return nil
+}
+
+func (p *hookPlayer) AfterDelete() error {
+	p.calls = append(p.calls, "AfterDelete")
+	return nil
+}
+
+func (p *hookPlayer) AfterFind() error {
+	p.calls = append(p.calls, "AfterFind")
+	return nil
+}
+
+func TestHooks(t *testing.T) {
+	exec := nebulaormtest.NewExecutor()
+	exec.Expect(`INSERT VERTEX player(name) VALUES "player100":("Tim Duncan");`)
+	exec.Expect(`INSERT VERTEX player(name) VALUES "player101":("Tony Parker");`).WillFail(nebula.ErrorCode_E_EXECUTION_ERROR, "execution error")
+	db, _ := nebulaorm.OpenWithExecutor(&nebulaorm.Config{}, exec)
+
+	p := &hookPlayer{VID: "player100", Name: "Tim Duncan"}
+	if err := db.InsertVertex(p).Exec(); err != nil {
+		t.Errorf("Exec() error = %v", err)
+	}
+	if want := []string{"BeforeInsert", "AfterInsert"}; !reflect.DeepEqual(p.calls, want) {
+		t.Errorf("hooks called = %v, want %v", p.calls, want)
+	}
+	// the statement is not executed if the before hook fails, so no expectation is consumed
+	p = &hookPlayer{VID: "player102"}
+	if err := db.InsertVertex(p).Exec(); !errors.Is(err, errNameRequired) {
+		t.Errorf("Exec() error = %v, want %v", err, errNameRequired)
+	}
+	// the after hooks are not called if the statement fails
+	p = &hookPlayer{VID: "player101", Name: "Tony Parker"}
+	if err := db.InsertVertex(p).Exec(); err == nil {
+		t.Errorf("Exec() should return the error of the failed result")
+	}
+	if want := []string{"BeforeInsert"}; !reflect.DeepEqual(p.calls, want) {
+		t.Errorf("hooks called = %v, want %v", p.calls, want)
+	}
+	if err := exec.ExpectationsWereMet(); err != nil {
+		t.Errorf("ExpectationsWereMet() error = %v", err)
+	}
+}
+
+func TestHooksSave(t *testing.T) {
+	exec := nebulaormtest.NewExecutor()
+	exec.Expect(`UPSERT VERTEX ON player "player100" SET name = "TIM DUNCAN";`)
+	exec.Expect(`UPDATE VERTEX ON player "player101" SET name = "TONY PARKER";`)
+	db, _ := nebulaorm.OpenWithExecutor(&nebulaorm.Config{}, exec)
+
+	// the sentences of Save are built after the before hooks, and the hooks are called only once
+	p := &hookPlayer{VID: "player100", Name: "Tim Duncan"}
+	if n, err := db.Save(p); n != 1 || err != nil {
+		t.Errorf("Save() got = %d, error = %v", n, err)
+	}
+	if want := []string{"BeforeUpdate", "AfterUpdate"}; !reflect.DeepEqual(p.calls, want) {
+		t.Errorf("hooks called = %v, want %v", p.calls, want)
+	}
+	p = &hookPlayer{VID: "player101", Name: "Tony Parker"}
+	if err := db.UpdateVertex(p.VID, p).Exec(); err != nil {
+		t.Errorf("Exec() error = %v", err)
+	}
+	if want := []string{"BeforeUpdate", "AfterUpdate"}; !reflect.DeepEqual(p.calls, want) {
+		t.Errorf("hooks called = %v, want %v", p.calls, want)
+	}
+	if err := exec.ExpectationsWereMet(); err != nil {
+		t.Errorf("ExpectationsWereMet() error = %v", err)
+	}
+}
+
+func TestHooksDeleteTag(t *testing.T) {
+	exec := nebulaormtest.NewExecutor()
+	exec.Expect(`DELETE TAG player, mvp FROM "player100";`)
+	db, _ := nebulaorm.OpenWithExecutor(&nebulaorm.Config{}, exec)
+
+	p := &hookPlayer{}
+	if err := db.DeleteTag([]interface{}{p, "mvp"}, "player100").Exec(); err != nil {
+		t.Errorf("Exec() error = %v", err)
+	}
+	if want := []string{"BeforeDelete", "AfterDelete"}; !reflect.DeepEqual(p.calls, want) {
+		t.Errorf("hooks called = %v, want %v", p.calls, want)
+	}
+	if err := exec.ExpectationsWereMet(); err != nil {
+		t.Errorf("ExpectationsWereMet() error = %v", err)
+	}
+}
+
+func TestHooksAfterFind(t *testing.T) {
+	const nGQL = `FETCH PROP ON player "player100", "player101" YIELD properties(vertex).name AS name;`
+	rows := [][]interface{}{{"Tim Duncan"}, {"Tony Parker"}}
+	exec := nebulaormtest.NewExecutor()
+	exec.Expect(nGQL).WillReturnRows([]string{"name"}, rows...)
+	exec.Expect(nGQL).WillReturnRows([]string{"name"}, rows...)
+	exec.Expect(`FETCH PROP ON player "player100" YIELD properties(vertex).name AS name | LIMIT 1;`).WillReturnRows([]string{"name"}, rows[0])
+	db, _ := nebulaorm.OpenWithExecutor(&nebulaorm.Config{}, exec)
+	fetch := func() *nebulaorm.DB {
+		return db.Fetch("player", []string{"player100", "player101"}).Yield("properties(vertex).name AS name")
+	}
+	want := []string{"AfterFind"}
+
+	var players []hookPlayer
+	if err := fetch().Find(&players); err != nil {
+		t.Errorf("Find() error = %v", err)
+	}
+	if len(players) != 2 || !reflect.DeepEqual(players[0].calls, want) || !reflect.DeepEqual(players[1].calls, want) {
+		t.Errorf("Find() got = %+v", players)
+	}
+	var playerPtrs []*hookPlayer
+	if err := fetch().Find(&playerPtrs); err != nil {
+		t.Errorf("Find() error = %v", err)
+	}
+	if len(playerPtrs) != 2 || !reflect.DeepEqual(playerPtrs[0].calls, want) || !reflect.DeepEqual(playerPtrs[1].calls, want) {
+		t.Errorf("Find() got = %+v", playerPtrs)
+	}
+	var p hookPlayer
+	if err := db.Fetch("player", "player100").Yield("properties(vertex).name AS name").Take(&p); err != nil {
+		t.Errorf("Take() error = %v", err)
+	}
+	if p.Name != "Tim Duncan" || !reflect.DeepEqual(p.calls, want) {
+		t.Errorf("Take() got = %+v", p)
+	}
+	if err := exec.ExpectationsWereMet(); err != nil {
+		t.Errorf("ExpectationsWereMet() error = %v", err)
+	}
+}
+
+func TestSkipHooks(t *testing.T) {
+	exec := nebulaormtest.NewExecutor()
+	exec.Expect(`INSERT VERTEX player(name) VALUES "player100":("");`)
+	exec.Expect(`FETCH PROP ON player "player100" YIELD properties(vertex).name AS name | LIMIT 1;`).
+		WillReturnRows([]string{"name"}, []interface{}{"Tim Duncan"})
+	db, _ := nebulaorm.OpenWithExecutor(&nebulaorm.Config{}, exec)
+
+	p := &hookPlayer{VID: "player100"}
+	if err := db.SkipHooks().InsertVertex(p).Exec(); err != nil {
+		t.Errorf("Exec() error = %v", err)
+	}
+	if len(p.calls) != 0 {
+		t.Errorf("hooks called = %v, want none", p.calls)
+	}
+	var found hookPlayer
+	if err := db.SkipHooks().Fetch("player", "player100").Yield("properties(vertex).name AS name").Take(&found); err != nil {
+		t.Errorf("Take() error = %v", err)
+	}
+	if len(found.calls) != 0 {
+		t.Errorf("hooks called = %v, want none", found.calls)
+	}
+	if err := exec.ExpectationsWereMet(); err != nil {
+		t.Errorf("ExpectationsWereMet() error = %v", err)
+	}
+}
+
+func TestHooksModelByValue(t *testing.T) {
+	exec := nebulaormtest.NewExecutor()
+	exec.Expect(`INSERT VERTEX player(name) VALUES "player100":("");`)
+	db, _ := nebulaorm.OpenWithExecutor(&nebulaorm.Config{}, exec)
+
+	// the model passed by value is not addressable, so the hooks declared on the pointer receiver are not called
+	if err := db.InsertVertex(hookPlayer{VID: "player100"}).Exec(); err != nil {
+		t.Errorf("Exec() error = %v", err)
+	}
+	if err := exec.ExpectationsWereMet(); err != nil {
+		t.Errorf("ExpectationsWereMet() error = %v", err)
+	}
+}
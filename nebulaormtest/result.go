@@ -0,0 +1,312 @@
+package nebulaormtest
+
+import (
+	"fmt"
+	"github.com/haysons/nebulaorm/resolver"
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+	nebulatype "github.com/vesoft-inc/nebula-go/v3/nebula"
+	"github.com/vesoft-inc/nebula-go/v3/nebula/graph"
+	"reflect"
+	"time"
+)
+
+// NewResultSet construct a succeeded result set from the column names and the rows, each row contains the values of
+// all the columns, the values are converted by Value
+func NewResultSet(colNames []string, rows ...[]interface{}) (*nebula.ResultSet, error) {
+	resp := &graph.ExecutionResponse{ErrorCode: nebulatype.ErrorCode_SUCCEEDED}
+	if len(colNames) > 0 {
+		dataSet := &nebulatype.DataSet{
+			ColumnNames: make([][]byte, 0, len(colNames)),
+			Rows:        make([]*nebulatype.Row, 0, len(rows)),
+		}
+		for _, colName := range colNames {
+			dataSet.ColumnNames = append(dataSet.ColumnNames, []byte(colName))
+		}
+		for i, row := range rows {
+			if len(row) != len(colNames) {
+				return nil, fmt.Errorf("nebulaormtest: row %d has %d values, but there are %d columns", i, len(row), len(colNames))
+			}
+			values := make([]*nebulatype.Value, 0, len(row))
+			for _, v := range row {
+				value, err := Value(v)
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, value)
+			}
+			dataSet.Rows = append(dataSet.Rows, &nebulatype.Row{Values: values})
+		}
+		resp.Data = dataSet
+	}
+	return nebula.GenResultSet(resp)
+}
+
+// NewErrorResultSet construct a result set that is not succeed
+func NewErrorResultSet(code nebula.ErrorCode, msg string) (*nebula.ResultSet, error) {
+	return nebula.GenResultSet(&graph.ExecutionResponse{
+		ErrorCode: nebulatype.ErrorCode(code),
+		ErrorMsg:  []byte(msg),
+	})
+}
+
+// Set the values are converted to a nebula graph set instead of a list
+type Set []interface{}
+
+// Date the time is converted to a nebula graph date, the year, month and day of the time are used
+type Date time.Time
+
+// Time the time is converted to a nebula graph time in UTC
+type Time time.Time
+
+// Path the vertexes and edges are converted to a nebula graph path, Start and the Dst of each step are vertex structs,
+// the Edge of each step is an edge struct, the direction of the step is decided by whether the src_id of the edge is the
+// previous vertex
+type Path struct {
+	Start interface{}
+	Steps []Step
+}
+
+// Step of the path
+type Step struct {
+	Edge interface{}
+	Dst  interface{}
+}
+
+// Value convert golang value to nebula graph value:
+//   - nil and nil pointer -> NULL, resolver.Valuer -> the value returned by NebulaValue
+//   - bool -> bool, integers -> int, floats -> float, string -> string
+//   - time.Time -> datetime in UTC, Date -> date, Time -> time
+//   - slice and array -> list, Set -> set, map with string key -> map
+//   - struct implementing resolver.EdgeTypeNamer -> edge
+//   - struct implementing resolver.VertexIDStr or resolver.VertexIDInt64 with tags -> vertex
+//   - Path -> path
+func Value(v interface{}) (*nebulatype.Value, error) {
+	switch val := v.(type) {
+	case nil:
+		null := nebulatype.NullType___NULL__
+		return &nebulatype.Value{NVal: &null}, nil
+	case *nebulatype.Value:
+		return val, nil
+	case resolver.Valuer:
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+			return Value(nil)
+		}
+		return Value(val.NebulaValue())
+	case time.Time:
+		utc := val.UTC()
+		return &nebulatype.Value{DtVal: &nebulatype.DateTime{
+			Year:     int16(utc.Year()),
+			Month:    int8(utc.Month()),
+			Day:      int8(utc.Day()),
+			Hour:     int8(utc.Hour()),
+			Minute:   int8(utc.Minute()),
+			Sec:      int8(utc.Second()),
+			Microsec: int32(utc.Nanosecond() / 1000),
+		}}, nil
+	case Date:
+		t := time.Time(val)
+		return &nebulatype.Value{DVal: &nebulatype.Date{Year: int16(t.Year()), Month: int8(t.Month()), Day: int8(t.Day())}}, nil
+	case Time:
+		utc := time.Time(val).UTC()
+		return &nebulatype.Value{TVal: &nebulatype.Time{
+			Hour:     int8(utc.Hour()),
+			Minute:   int8(utc.Minute()),
+			Sec:      int8(utc.Second()),
+			Microsec: int32(utc.Nanosecond() / 1000),
+		}}, nil
+	case Set:
+		values, err := listValues(reflect.ValueOf([]interface{}(val)))
+		if err != nil {
+			return nil, err
+		}
+		return &nebulatype.Value{UVal: &nebulatype.NSet{Values: values}}, nil
+	case Path:
+		path, err := pathValue(val)
+		if err != nil {
+			return nil, err
+		}
+		return &nebulatype.Value{PVal: path}, nil
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return Value(nil)
+		}
+		return Value(rv.Elem().Interface())
+	case reflect.Bool:
+		b := rv.Bool()
+		return &nebulatype.Value{BVal: &b}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i := rv.Int()
+		return &nebulatype.Value{IVal: &i}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		i := int64(rv.Uint())
+		return &nebulatype.Value{IVal: &i}, nil
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		return &nebulatype.Value{FVal: &f}, nil
+	case reflect.String:
+		return &nebulatype.Value{SVal: []byte(rv.String())}, nil
+	case reflect.Slice, reflect.Array:
+		values, err := listValues(rv)
+		if err != nil {
+			return nil, err
+		}
+		return &nebulatype.Value{LVal: &nebulatype.NList{Values: values}}, nil
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("nebulaormtest: can not convert %T to nebula graph map, the key should be string", v)
+		}
+		kvs := make(map[string]*nebulatype.Value, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			value, err := Value(iter.Value().Interface())
+			if err != nil {
+				return nil, err
+			}
+			kvs[iter.Key().String()] = value
+		}
+		return &nebulatype.Value{MVal: &nebulatype.NMap{Kvs: kvs}}, nil
+	case reflect.Struct:
+		if _, ok := reflect.New(rv.Type()).Interface().(resolver.EdgeTypeNamer); ok {
+			edge, err := edgeValue(rv)
+			if err != nil {
+				return nil, err
+			}
+			return &nebulatype.Value{EVal: edge}, nil
+		}
+		vertex, err := vertexValue(rv)
+		if err != nil {
+			return nil, err
+		}
+		return &nebulatype.Value{VVal: vertex}, nil
+	}
+	return nil, fmt.Errorf("nebulaormtest: can not convert %T to nebula graph value", v)
+}
+
+func listValues(rv reflect.Value) ([]*nebulatype.Value, error) {
+	values := make([]*nebulatype.Value, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		value, err := Value(rv.Index(i).Interface())
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// vertexValue convert the vertex struct to nebula graph vertex, the props of each tag are converted by Value, and the
+// props with serializer are serialized into string
+func vertexValue(rv reflect.Value) (*nebulatype.Vertex, error) {
+	rv = reflect.Indirect(rv)
+	vertexSchema, err := resolver.ParseVertex(rv.Type())
+	if err != nil {
+		return nil, fmt.Errorf("nebulaormtest: can not convert %s to nebula graph vertex: %w", rv.Type(), err)
+	}
+	vid, err := Value(vertexSchema.GetVID(rv))
+	if err != nil {
+		return nil, err
+	}
+	vertex := &nebulatype.Vertex{Vid: vid}
+	for _, tag := range vertexSchema.GetTags() {
+		props, err := propValues(rv, tag.GetProps())
+		if err != nil {
+			return nil, err
+		}
+		vertex.Tags = append(vertex.Tags, &nebulatype.Tag{Name: []byte(tag.TagName), Props: props})
+	}
+	return vertex, nil
+}
+
+// edgeValue convert the edge struct to nebula graph edge
+func edgeValue(rv reflect.Value) (*nebulatype.Edge, error) {
+	rv = reflect.Indirect(rv)
+	edgeSchema, err := resolver.ParseEdge(rv.Type())
+	if err != nil {
+		return nil, fmt.Errorf("nebulaormtest: can not convert %s to nebula graph edge: %w", rv.Type(), err)
+	}
+	src, err := Value(edgeSchema.GetSrcVID(rv))
+	if err != nil {
+		return nil, err
+	}
+	dst, err := Value(edgeSchema.GetDstVID(rv))
+	if err != nil {
+		return nil, err
+	}
+	props, err := propValues(rv, edgeSchema.GetProps())
+	if err != nil {
+		return nil, err
+	}
+	return &nebulatype.Edge{
+		Src:     src,
+		Dst:     dst,
+		Type:    1,
+		Name:    []byte(edgeSchema.GetTypeName()),
+		Ranking: edgeSchema.GetRank(rv),
+		Props:   props,
+	}, nil
+}
+
+func propValues(rv reflect.Value, props []*resolver.Prop) (map[string]*nebulatype.Value, error) {
+	values := make(map[string]*nebulatype.Value, len(props))
+	for _, prop := range props {
+		fieldValue := rv.FieldByIndex(prop.StructField.Index)
+		var value *nebulatype.Value
+		var err error
+		if prop.Serializer != nil && !(fieldValue.Kind() == reflect.Ptr && fieldValue.IsNil()) {
+			var data []byte
+			if data, err = prop.Serializer.Marshal(fieldValue.Interface()); err != nil {
+				return nil, err
+			}
+			value, err = Value(string(data))
+		} else {
+			value, err = Value(fieldValue.Interface())
+		}
+		if err != nil {
+			return nil, err
+		}
+		values[prop.Name] = value
+	}
+	return values, nil
+}
+
+// pathValue convert Path to nebula graph path
+func pathValue(p Path) (*nebulatype.Path, error) {
+	start, err := Value(p.Start)
+	if err != nil {
+		return nil, err
+	}
+	if start.VVal == nil {
+		return nil, fmt.Errorf("nebulaormtest: the start of the path should be a vertex, but got %T", p.Start)
+	}
+	path := &nebulatype.Path{Src: start.VVal}
+	prev := start.VVal
+	for i, step := range p.Steps {
+		edge, err := Value(step.Edge)
+		if err != nil {
+			return nil, err
+		}
+		dst, err := Value(step.Dst)
+		if err != nil {
+			return nil, err
+		}
+		if edge.EVal == nil || dst.VVal == nil {
+			return nil, fmt.Errorf("nebulaormtest: step %d of the path should contain an edge and a vertex", i)
+		}
+		edgeType := nebulatype.EdgeType(1)
+		if !reflect.DeepEqual(edge.EVal.Src, prev.Vid) {
+			edgeType = -1
+		}
+		path.Steps = append(path.Steps, &nebulatype.Step{
+			Dst:     dst.VVal,
+			Type:    edgeType,
+			Name:    edge.EVal.Name,
+			Ranking: edge.EVal.Ranking,
+			Props:   edge.EVal.Props,
+		})
+		prev = dst.VVal
+	}
+	return path, nil
+}
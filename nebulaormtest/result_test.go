@@ -0,0 +1,91 @@
+package nebulaormtest_test
+
+import (
+	"github.com/haysons/nebulaorm"
+	"github.com/haysons/nebulaorm/nebulaormtest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type team struct {
+	VID  string `norm:"vertex_id"`
+	Name string `norm:"prop:name"`
+}
+
+func (t team) VertexID() string {
+	return t.VID
+}
+
+func (t team) VertexTagName() string {
+	return "team"
+}
+
+type serve struct {
+	SrcID     string `norm:"edge_src_id"`
+	DstID     string `norm:"edge_dst_id"`
+	Rank      int    `norm:"edge_rank"`
+	StartYear int64  `norm:"prop:start_year"`
+}
+
+func (s serve) EdgeTypeName() string {
+	return "serve"
+}
+
+func TestNewResultSet(t *testing.T) {
+	tim := player{VID: "player100", Name: "Tim Duncan", Age: 42}
+	spurs := team{VID: "team204", Name: "Spurs"}
+	edge := serve{SrcID: "player100", DstID: "team204", Rank: 1, StartYear: 1997}
+	born := time.Date(1976, 4, 25, 0, 0, 0, 0, time.UTC)
+	rs, err := nebulaormtest.NewResultSet(
+		[]string{"v", "e", "tags", "props", "uniq", "born", "updated", "p", "nothing"},
+		[]interface{}{
+			tim, edge, []string{"a", "b"}, map[string]interface{}{"x": 1}, nebulaormtest.Set{1, 2},
+			nebulaormtest.Date(born), born, nebulaormtest.Path{Start: tim, Steps: []nebulaormtest.Step{{Edge: edge, Dst: spurs}}}, nil,
+		},
+	)
+	if err != nil {
+		t.Fatalf("NewResultSet() error = %v", err)
+	}
+
+	type row struct {
+		V       player                 `norm:"col:v"`
+		E       serve                  `norm:"col:e"`
+		Tags    []string               `norm:"col:tags"`
+		Props   map[string]interface{} `norm:"col:props"`
+		Uniq    []int                  `norm:"col:uniq"`
+		Born    time.Time              `norm:"col:born"`
+		Updated time.Time              `norm:"col:updated"`
+		Nothing *string                `norm:"col:nothing"`
+	}
+	var got row
+	if err = nebulaorm.Scan(rs, &got); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if !reflect.DeepEqual(got.V, tim) {
+		t.Errorf("Scan() vertex got = %+v, want %+v", got.V, tim)
+	}
+	if !reflect.DeepEqual(got.E, edge) {
+		t.Errorf("Scan() edge got = %+v, want %+v", got.E, edge)
+	}
+	if !reflect.DeepEqual(got.Tags, []string{"a", "b"}) || got.Props["x"] != int64(1) || !reflect.DeepEqual(got.Uniq, []int{1, 2}) {
+		t.Errorf("Scan() list, map or set got = %+v", got)
+	}
+	if !got.Born.Equal(born) || !got.Updated.Equal(born) || got.Nothing != nil {
+		t.Errorf("Scan() date, datetime or null got = %+v", got)
+	}
+
+	var names [][]string
+	if err = nebulaorm.Pluck(rs, "tags", &names); err != nil || len(names) != 1 || len(names[0]) != 2 {
+		t.Errorf("Pluck() got = %v, error = %v", names, err)
+	}
+	record, _ := rs.GetRowValuesByIndex(0)
+	pathValue, _ := record.GetValueByColName("p")
+	path, err := pathValue.AsPath()
+	if err != nil {
+		t.Fatalf("AsPath() error = %v", err)
+	}
+	if len(path.GetNodes()) != 2 || len(path.GetRelationships()) != 1 || path.GetRelationships()[0].GetEdgeName() != "serve" {
+		t.Errorf("AsPath() got = %v", path.String())
+	}
+}
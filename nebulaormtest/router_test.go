@@ -0,0 +1,102 @@
+package nebulaormtest_test
+
+import (
+	"errors"
+	"github.com/haysons/nebulaorm"
+	"github.com/haysons/nebulaorm/clause"
+	"github.com/haysons/nebulaorm/nebulaormtest"
+	"testing"
+)
+
+func TestRouter(t *testing.T) {
+	primary, replica1, replica2, analytics := nebulaormtest.NewExecutor(), nebulaormtest.NewExecutor(), nebulaormtest.NewExecutor(), nebulaormtest.NewExecutor()
+	conf := &nebulaorm.Config{
+		SpaceName:  "test",
+		ReadPolicy: nebulaorm.ReadPolicyReplica,
+		Clusters: []nebulaorm.ClusterConfig{
+			{Name: "replica1", Replica: true},
+			{Name: "replica2", Replica: true},
+			{Name: "analytics"},
+		},
+	}
+	db, err := nebulaorm.OpenWithExecutor(conf, primary,
+		nebulaorm.WithClusterExecutor("replica1", replica1),
+		nebulaorm.WithClusterExecutor("replica2", replica2),
+		nebulaorm.WithClusterExecutor("analytics", analytics))
+	if err != nil {
+		t.Fatalf("OpenWithExecutor() error = %v", err)
+	}
+
+	// the write statements are always executed on the primary cluster
+	primary.Expect(`INSERT VERTEX player(name, age) VALUES "player100":("Tim Duncan", 42);`)
+	primary.Expect(`UPDATE VERTEX ON player "player100" SET age = 43;`)
+	primary.Expect(`DELETE VERTEX "player100";`)
+	if err = db.InsertVertex(&player{VID: "player100", Name: "Tim Duncan", Age: 42}).Exec(); err != nil {
+		t.Errorf("InsertVertex() error = %v", err)
+	}
+	if err = db.UpdateVertex("player100", map[string]interface{}{"age": 43}, clause.WithTagName("player")).Exec(); err != nil {
+		t.Errorf("UpdateVertex() error = %v", err)
+	}
+	if err = db.DeleteVertex("player100").Exec(); err != nil {
+		t.Errorf("DeleteVertex() error = %v", err)
+	}
+
+	// the read statements are executed on the replicas in turn
+	fetch := `FETCH PROP ON player "player100" YIELD properties(vertex).name AS name;`
+	replica1.Expect(fetch)
+	replica2.Expect(fetch)
+	replica1.Expect(fetch)
+	for i := 0; i < 3; i++ {
+		if err = db.Fetch("player", "player100").Yield("properties(vertex).name AS name").Exec(); err != nil {
+			t.Errorf("Fetch() #%d error = %v", i, err)
+		}
+	}
+
+	// the specified cluster takes precedence over the read policy, for both read and write statements
+	analytics.Expect(fetch)
+	primary.Expect(fetch)
+	analytics.Expect(`UPDATE VERTEX ON player "player100" SET age = 44;`)
+	if err = db.Cluster("analytics").Fetch("player", "player100").Yield("properties(vertex).name AS name").Exec(); err != nil {
+		t.Errorf("Cluster(analytics) Fetch() error = %v", err)
+	}
+	if err = db.Cluster(nebulaorm.ClusterPrimary).Fetch("player", "player100").Yield("properties(vertex).name AS name").Exec(); err != nil {
+		t.Errorf("Cluster(primary) Fetch() error = %v", err)
+	}
+	if err = db.Cluster("analytics").UpdateVertex("player100", map[string]interface{}{"age": 44}, clause.WithTagName("player")).Exec(); err != nil {
+		t.Errorf("Cluster(analytics) UpdateVertex() error = %v", err)
+	}
+
+	// the unknown cluster
+	if err = db.Cluster("unknown").Fetch("player", "player100").Yield("properties(vertex).name AS name").Exec(); !errors.Is(err, nebulaorm.ErrInvalidValue) {
+		t.Errorf("Cluster(unknown) error = %v, want %v", err, nebulaorm.ErrInvalidValue)
+	}
+
+	for name, exec := range map[string]*nebulaormtest.Executor{"primary": primary, "replica1": replica1, "replica2": replica2, "analytics": analytics} {
+		if err = exec.ExpectationsWereMet(); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+}
+
+func TestRouterReadPolicyPrimary(t *testing.T) {
+	primary, replica := nebulaormtest.NewExecutor(), nebulaormtest.NewExecutor()
+	conf := &nebulaorm.Config{Clusters: []nebulaorm.ClusterConfig{{Name: "replica", Replica: true}}}
+	db, err := nebulaorm.OpenWithExecutor(conf, primary, nebulaorm.WithClusterExecutor("replica", replica))
+	if err != nil {
+		t.Fatalf("OpenWithExecutor() error = %v", err)
+	}
+	primary.Expect(`FETCH PROP ON player "player100" YIELD vertex AS v;`)
+	if err = db.Fetch("player", "player100").Yield("vertex AS v").Exec(); err != nil {
+		t.Errorf("Fetch() error = %v", err)
+	}
+	if err = primary.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRouterMissingClusterExecutor(t *testing.T) {
+	conf := &nebulaorm.Config{Clusters: []nebulaorm.ClusterConfig{{Name: "replica", Replica: true}}}
+	if _, err := nebulaorm.OpenWithExecutor(conf, nebulaormtest.NewExecutor()); !errors.Is(err, nebulaorm.ErrInvalidValue) {
+		t.Errorf("OpenWithExecutor() error = %v, want %v", err, nebulaorm.ErrInvalidValue)
+	}
+}
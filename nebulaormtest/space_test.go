@@ -0,0 +1,91 @@
+package nebulaormtest_test
+
+import (
+	"errors"
+	"github.com/haysons/nebulaorm"
+	"github.com/haysons/nebulaorm/nebulaormtest"
+	"testing"
+)
+
+type spacePlayer struct {
+	VID  string `norm:"vertex_id"`
+	Name string `norm:"prop:name"`
+}
+
+func (p spacePlayer) VertexID() string {
+	return p.VID
+}
+
+func (p spacePlayer) VertexTagName() string {
+	return "player"
+}
+
+func (p spacePlayer) SpaceName() string {
+	return "basketball"
+}
+
+type spaceTeam struct {
+	VID  string `norm:"vertex_id"`
+	Name string `norm:"prop:name"`
+}
+
+func (t spaceTeam) VertexID() string {
+	return t.VID
+}
+
+func (t spaceTeam) VertexTagName() string {
+	return "team"
+}
+
+func (t spaceTeam) SpaceName() string {
+	return "league"
+}
+
+func TestSpace(t *testing.T) {
+	exec := nebulaormtest.NewExecutor()
+	exec.Expect(`USE basketball; FETCH PROP ON player "player100" YIELD vertex AS v;`)
+	exec.Expect(`FETCH PROP ON player "player100" YIELD vertex AS v;`)
+	exec.Expect(`USE basketball; INSERT VERTEX player(name) VALUES "player100":("Tim Duncan");`)
+	exec.Expect(`USE basketball; INSERT VERTEX player(name) VALUES "player101":("Tony Parker");`)
+	exec.Expect(`USE basketball; FETCH PROP ON player "player100" YIELD vertex AS v;`).
+		WillReturnRows([]string{"v"}, []interface{}{spacePlayer{VID: "player100", Name: "Tim Duncan"}})
+	exec.Expect(`USE basketball; DELETE TAG player, mvp FROM "player100";`)
+	exec.Expect(`DELETE TAG player FROM "player100";`)
+	db, _ := nebulaorm.OpenWithExecutor(&nebulaorm.Config{SpaceName: "test"}, exec)
+
+	if err := db.Space("basketball").Fetch("player", "player100").Yield("vertex AS v").Exec(); err != nil {
+		t.Errorf("Exec() error = %v", err)
+	}
+	// the space of the connection does not need USE
+	if err := db.Space("test").Fetch("player", "player100").Yield("vertex AS v").Exec(); err != nil {
+		t.Errorf("Exec() error = %v", err)
+	}
+	if err := db.InsertVertex(&spacePlayer{VID: "player100", Name: "Tim Duncan"}).Exec(); err != nil {
+		t.Errorf("Exec() error = %v", err)
+	}
+	// the model space does not depend on the hooks
+	if err := db.SkipHooks().InsertVertex(&spacePlayer{VID: "player101", Name: "Tony Parker"}).Exec(); err != nil {
+		t.Errorf("Exec() error = %v", err)
+	}
+	var players []spacePlayer
+	if err := db.Fetch("player", "player100").Yield("vertex AS v").FindCol("v", &players); err != nil {
+		t.Errorf("FindCol() error = %v", err)
+	}
+	if len(players) != 1 || players[0].Name != "Tim Duncan" {
+		t.Errorf("FindCol() got = %+v", players)
+	}
+	// the space of the tag struct, while the tag name has no space
+	if err := db.DeleteTag([]interface{}{(*spacePlayer)(nil), "mvp"}, "player100").Exec(); err != nil {
+		t.Errorf("Exec() error = %v", err)
+	}
+	if err := db.DeleteTag([]string{"player"}, "player100").Exec(); err != nil {
+		t.Errorf("Exec() error = %v", err)
+	}
+	_, err := db.Save([]interface{}{&spacePlayer{VID: "player100"}, &spaceTeam{VID: "team204"}})
+	if !errors.Is(err, nebulaorm.ErrInvalidValue) {
+		t.Errorf("Save() error = %v, want %v", err, nebulaorm.ErrInvalidValue)
+	}
+	if err = exec.ExpectationsWereMet(); err != nil {
+		t.Errorf("ExpectationsWereMet() error = %v", err)
+	}
+}
@@ -1,6 +1,9 @@
 package nebulaorm
 
-import "github.com/haysons/nebulaorm/clause"
+import (
+	"github.com/haysons/nebulaorm/clause"
+	"github.com/haysons/nebulaorm/statement"
+)
 
 // Raw exec nGQL statements natively
 // see more information on the method of the same name in statement.Statement
@@ -36,7 +39,7 @@ func (db *DB) Over(edgeType ...string) (tx *DB) {
 
 // Where generate where clause
 // see more information on the method of the same name in statement.Statement
-func (db *DB) Where(query string, args ...interface{}) (tx *DB) {
+func (db *DB) Where(query interface{}, args ...interface{}) (tx *DB) {
 	tx = db.getInstance()
 	tx.Statement.Where(query, args...)
 	return
@@ -44,7 +47,7 @@ func (db *DB) Where(query string, args ...interface{}) (tx *DB) {
 
 // Or generate or clause
 // see more information on the method of the same name in statement.Statement
-func (db *DB) Or(query string, args ...interface{}) (tx *DB) {
+func (db *DB) Or(query interface{}, args ...interface{}) (tx *DB) {
 	tx = db.getInstance()
 	tx.Statement.Or(query, args...)
 	return
@@ -52,7 +55,7 @@ func (db *DB) Or(query string, args ...interface{}) (tx *DB) {
 
 // Not generate not clause
 // see more information on the method of the same name in statement.Statement
-func (db *DB) Not(query string, args ...interface{}) (tx *DB) {
+func (db *DB) Not(query interface{}, args ...interface{}) (tx *DB) {
 	tx = db.getInstance()
 	tx.Statement.Not(query, args...)
 	return
@@ -60,7 +63,7 @@ func (db *DB) Not(query string, args ...interface{}) (tx *DB) {
 
 // Xor generate xor clause
 // see more information on the method of the same name in statement.Statement
-func (db *DB) Xor(query string, args ...interface{}) (tx *DB) {
+func (db *DB) Xor(query interface{}, args ...interface{}) (tx *DB) {
 	tx = db.getInstance()
 	tx.Statement.Xor(query, args...)
 	return
@@ -90,6 +93,14 @@ func (db *DB) FetchMulti(names []string, vid interface{}) (tx *DB) {
 	return
 }
 
+// FetchEdge generate fetch clause for the edges
+// see more information on the method of the same name in statement.Statement
+func (db *DB) FetchEdge(edgeTypeName string, edges interface{}) (tx *DB) {
+	tx = db.getInstance()
+	tx.Statement.FetchEdge(edgeTypeName, edges)
+	return
+}
+
 // Lookup generate lookup clause
 // see more information on the method of the same name in statement.Statement
 func (db *DB) Lookup(name string) (tx *DB) {
@@ -98,6 +109,14 @@ func (db *DB) Lookup(name string) (tx *DB) {
 	return
 }
 
+// LookupEdge generate lookup clause on the edge type
+// see more information on the method of the same name in statement.Statement
+func (db *DB) LookupEdge(name string) (tx *DB) {
+	tx = db.getInstance()
+	tx.Statement.LookupEdge(name)
+	return
+}
+
 // GroupBy generate group by clause
 // see more information on the method of the same name in statement.Statement
 func (db *DB) GroupBy(expr string) (tx *DB) {
@@ -114,6 +133,14 @@ func (db *DB) Yield(expr string, distinct ...bool) (tx *DB) {
 	return
 }
 
+// YieldModel generate yield clause from the columns of the record struct, the dest is usually the same as the dest of Find
+// see more information on the method of the same name in statement.Statement
+func (db *DB) YieldModel(dest interface{}, distinct ...bool) (tx *DB) {
+	tx = db.getInstance()
+	tx.Statement.YieldModel(dest, distinct...)
+	return
+}
+
 // OrderBy generate order by clause
 // see more information on the method of the same name in statement.Statement
 func (db *DB) OrderBy(expr string) (tx *DB) {
@@ -135,6 +162,7 @@ func (db *DB) Limit(limit int, offset ...int) (tx *DB) {
 func (db *DB) InsertVertex(vertexes interface{}, ifNotExist ...bool) (tx *DB) {
 	tx = db.getInstance()
 	tx.Statement.InsertVertex(vertexes, ifNotExist...)
+	tx.addModel(hookInsert, vertexes)
 	return
 }
 
@@ -143,6 +171,7 @@ func (db *DB) InsertVertex(vertexes interface{}, ifNotExist ...bool) (tx *DB) {
 func (db *DB) UpdateVertex(vid interface{}, propsUpdate interface{}, opts ...clause.Option) (tx *DB) {
 	tx = db.getInstance()
 	tx.Statement.UpdateVertex(vid, propsUpdate, opts...)
+	tx.addModel(hookUpdate, propsUpdate)
 	return
 }
 
@@ -151,6 +180,7 @@ func (db *DB) UpdateVertex(vid interface{}, propsUpdate interface{}, opts ...cla
 func (db *DB) UpsertVertex(vid interface{}, propsUpdate interface{}, opts ...clause.Option) (tx *DB) {
 	tx = db.getInstance()
 	tx.Statement.UpsertVertex(vid, propsUpdate, opts...)
+	tx.addModel(hookUpdate, propsUpdate)
 	return
 }
 
@@ -159,6 +189,7 @@ func (db *DB) UpsertVertex(vid interface{}, propsUpdate interface{}, opts ...cla
 func (db *DB) DeleteVertex(vid interface{}, withEdge ...bool) (tx *DB) {
 	tx = db.getInstance()
 	tx.Statement.DeleteVertex(vid, withEdge...)
+	tx.addModel(hookDelete, vid)
 	return
 }
 
@@ -167,6 +198,7 @@ func (db *DB) DeleteVertex(vid interface{}, withEdge ...bool) (tx *DB) {
 func (db *DB) InsertEdge(edges interface{}, ifNotExist ...bool) (tx *DB) {
 	tx = db.getInstance()
 	tx.Statement.InsertEdge(edges, ifNotExist...)
+	tx.addModel(hookInsert, edges)
 	return
 }
 
@@ -175,6 +207,7 @@ func (db *DB) InsertEdge(edges interface{}, ifNotExist ...bool) (tx *DB) {
 func (db *DB) UpdateEdge(edge interface{}, propsUpdate interface{}, opts ...clause.Option) (tx *DB) {
 	tx = db.getInstance()
 	tx.Statement.UpdateEdge(edge, propsUpdate, opts...)
+	tx.addModel(hookUpdate, propsUpdate)
 	return
 }
 
@@ -183,6 +216,7 @@ func (db *DB) UpdateEdge(edge interface{}, propsUpdate interface{}, opts ...clau
 func (db *DB) UpsertEdge(edge interface{}, propsUpdate interface{}, opts ...clause.Option) (tx *DB) {
 	tx = db.getInstance()
 	tx.Statement.UpsertEdge(edge, propsUpdate, opts...)
+	tx.addModel(hookUpdate, propsUpdate)
 	return
 }
 
@@ -191,12 +225,48 @@ func (db *DB) UpsertEdge(edge interface{}, propsUpdate interface{}, opts ...clau
 func (db *DB) DeleteEdge(edgeTypeName string, edge interface{}) (tx *DB) {
 	tx = db.getInstance()
 	tx.Statement.DeleteEdge(edgeTypeName, edge)
+	tx.addModel(hookDelete, edge)
+	return
+}
+
+// DeleteTag generate delete tag clause
+// see more information on the method of the same name in statement.Statement
+func (db *DB) DeleteTag(tagNames interface{}, vid interface{}) (tx *DB) {
+	tx = db.getInstance()
+	tx.Statement.DeleteTag(tagNames, vid)
+	tx.addTagModels(tagNames)
+	return
+}
+
+// DeleteVertices delete the vertices matched by the LOOKUP or GO statement, ErrMissingWhereClause is returned if the
+// statement has no where clause, unless AllowGlobalDelete is called
+// see more information on the method of the same name in statement.Statement
+func (db *DB) DeleteVertices(withEdge ...bool) (tx *DB) {
+	tx = db.getInstance()
+	tx.Statement.DeleteVertices(withEdge...)
+	return
+}
+
+// DeleteEdges delete the edges matched by the LOOKUP or GO statement, ErrMissingWhereClause is returned if the
+// statement has no where clause, unless AllowGlobalDelete is called
+// see more information on the method of the same name in statement.Statement
+func (db *DB) DeleteEdges() (tx *DB) {
+	tx = db.getInstance()
+	tx.Statement.DeleteEdges()
+	return
+}
+
+// AllowGlobalDelete allow DeleteVertices and DeleteEdges to delete all the items matched by the statement without
+// where clause, it must be called before them
+func (db *DB) AllowGlobalDelete() (tx *DB) {
+	tx = db.getInstance()
+	tx.Statement.AllowGlobalDelete()
 	return
 }
 
 // When generate when edge clause
 // see more information on the method of the same name in statement.Statement
-func (db *DB) When(query string, args ...interface{}) (tx *DB) {
+func (db *DB) When(query interface{}, args ...interface{}) (tx *DB) {
 	tx = db.getInstance()
 	tx.Statement.When(query, args...)
 	return
@@ -208,3 +278,55 @@ func (db *DB) Pipe() (tx *DB) {
 	tx.Statement.Pipe()
 	return
 }
+
+// Assign assign the result of the sub statement to the nGQL variable, the sub statement is built by a DB chain such as
+// db.Go().From(vid).Over(edge).Yield(...), and the variable is referenced by clause.Var
+// see more information on the method of the same name in statement.Statement
+func (db *DB) Assign(name string, sub *DB) (tx *DB) {
+	tx = db.getInstance()
+	tx.Statement.Assign(name, tx.mergeOperand(sub))
+	return
+}
+
+// Union combine the results of the current statement and the other statement built by a DB chain, the duplicate rows
+// are removed, and the subsequent clauses are piped on the combined results
+// see more information on the method of the same name in statement.Statement
+func (db *DB) Union(other *DB) (tx *DB) {
+	tx = db.getInstance()
+	tx.Statement.Union(tx.mergeOperand(other))
+	return
+}
+
+// UnionAll combine the results of the current statement and the other statement, the duplicate rows are kept
+// see more information on the method of the same name in statement.Statement
+func (db *DB) UnionAll(other *DB) (tx *DB) {
+	tx = db.getInstance()
+	tx.Statement.UnionAll(tx.mergeOperand(other))
+	return
+}
+
+// Intersect get the rows that are in the results of both the current statement and the other statement
+// see more information on the method of the same name in statement.Statement
+func (db *DB) Intersect(other *DB) (tx *DB) {
+	tx = db.getInstance()
+	tx.Statement.Intersect(tx.mergeOperand(other))
+	return
+}
+
+// Minus get the rows that are in the results of the current statement but not in the other statement
+// see more information on the method of the same name in statement.Statement
+func (db *DB) Minus(other *DB) (tx *DB) {
+	tx = db.getInstance()
+	tx.Statement.Minus(tx.mergeOperand(other))
+	return
+}
+
+// mergeOperand get the statement of the DB chain that is used in the current statement, and merge its models
+func (db *DB) mergeOperand(other *DB) *statement.Statement {
+	if other == nil {
+		return nil
+	}
+	db.models = append(db.models, other.models...)
+	db.hookModels = append(db.hookModels, other.hookModels...)
+	return other.Statement
+}
@@ -10,21 +10,76 @@ import (
 	"time"
 )
 
-// DB will use statement.Statement to construct the nGQL statement, and then hand it over to nebula.SessionPool to execute
-// the statement, and you can eventually get the result of the execution through methods such as Find Exec Pluck.  DB is
+// DB will use statement.Statement to construct the nGQL statement, and then hand it over to the Executor (nebula.SessionPool
+// by default) to execute the statement, and you can eventually get the result of the execution through methods such as Find Exec Pluck.  DB is
 // concurrency-safe, and multiple statements can be executed by a single DB object at the same time. The nebula graph
 // officially provides a SessionPool, which eliminates the need for the application layer to implement a connection pool.
 // So in most cases, the application layer only needs to use a single DB instance.
 // However, statement.Statement is not concurrency-safe, so don't concurrently build nGQL statements.
 // NOTE: No embedded field is supported for struct, so do not use embedded field when declaring struct.
 type DB struct {
-	Statement   *statement.Statement
-	conf        *Config
-	sessionPool *nebula.SessionPool
-	clone       int
+	Statement         *statement.Statement
+	conf              *Config
+	router            *router
+	resolver          *resolver.Resolver
+	clone             int
+	space             string
+	cluster           string
+	models            []interface{}
+	hookModels        []hookModel
+	skipHooks         bool
+	beforeHooksCalled bool
+	dryRun            bool
+	recorder          *recorder
 }
 
 func Open(conf *Config, opts ...ConfigOption) (*DB, error) {
+	if err := initConfig(conf, opts...); err != nil {
+		return nil, err
+	}
+	db := newDB(conf)
+	if conf.DryRun {
+		return db, nil
+	}
+	r, err := newRouter(conf, newPoolFactory(conf))
+	if err != nil {
+		return nil, err
+	}
+	db.router = r
+	return db, nil
+}
+
+// OpenWithExecutor create a DB that executes statements through the executor instead of the session pools created
+// from Config, it is usually used to replace nebula graph with a fake executor in tests, see package nebulaormtest.
+// Config.Addresses is ignored, and the executor of each cluster in Config.Clusters must be given by WithClusterExecutor,
+// so that the routing of the statements can be tested as well.
+func OpenWithExecutor(conf *Config, executor Executor, opts ...ConfigOption) (*DB, error) {
+	if executor == nil {
+		return nil, fmt.Errorf("nebulaorm: %w, executor is nil", ErrInvalidValue)
+	}
+	if err := initConfig(conf, opts...); err != nil {
+		return nil, err
+	}
+	r, err := newRouter(conf, newGivenFactory(conf, executor))
+	if err != nil {
+		return nil, err
+	}
+	db := newDB(conf)
+	db.router = r
+	return db, nil
+}
+
+// New create a DB without connecting to nebula graph, the statements are built but not executed, it is usually used
+// with ToNGQL to test the query builders or generate nGQL scripts offline, see Config.DryRun
+func New(opts ...ConfigOption) (*DB, error) {
+	conf := &Config{DryRun: true}
+	if err := initConfig(conf, opts...); err != nil {
+		return nil, err
+	}
+	return newDB(conf), nil
+}
+
+func initConfig(conf *Config, opts ...ConfigOption) error {
 	for _, o := range opts {
 		o.apply(conf)
 	}
@@ -32,19 +87,32 @@ func Open(conf *Config, opts ...ConfigOption) (*DB, error) {
 	if conf.TimezoneName != "" {
 		loc, err := time.LoadLocation(conf.TimezoneName)
 		if err != nil {
-			return nil, fmt.Errorf("nebulaorm: load timezone failed: %v", err)
+			return fmt.Errorf("nebulaorm: load timezone failed: %v", err)
 		}
 		conf.timezone = loc
 	} else {
 		conf.timezone = time.Local
 	}
-	resolver.SetTimezone(conf.timezone)
+	return nil
+}
+
+func newDB(conf *Config) *DB {
+	db := &DB{
+		conf:     conf,
+		resolver: resolver.NewResolver(resolver.WithTimezone(conf.timezone)),
+		dryRun:   conf.DryRun,
+		clone:    1, // when clone is 1, the Statement object will be copied to ensure that the same singleton build statement does not affect each other.
+	}
+	db.Statement = statement.New(statement.WithResolver(db.resolver))
+	return db
+}
 
-	hostAddr, err := parseServerAddr(conf.Addresses)
+func newSessionPool(conf *Config, addresses []string, username, password, spaceName string) (*nebula.SessionPool, error) {
+	hostAddr, err := parseServerAddr(addresses)
 	if err != nil {
 		return nil, err
 	}
-	poolConf, err := nebula.NewSessionPoolConf(conf.Username, conf.Password, hostAddr, conf.SpaceName, parseSessionOptions(conf)...)
+	poolConf, err := nebula.NewSessionPoolConf(username, password, hostAddr, spaceName, parseSessionOptions(conf)...)
 	if err != nil {
 		return nil, fmt.Errorf("nebulaorm: build session pool conf failed: %v", err)
 	}
@@ -52,14 +120,7 @@ func Open(conf *Config, opts ...ConfigOption) (*DB, error) {
 	if err != nil {
 		return nil, fmt.Errorf("nebulaorm: create session pool failed: %v", err)
 	}
-
-	db := &DB{
-		Statement:   statement.New(),
-		conf:        conf,
-		sessionPool: pool,
-		clone:       1, // when clone is 1, the Statement object will be copied to ensure that the same singleton build statement does not affect each other.
-	}
-	return db, nil
+	return pool, nil
 }
 
 func parseServerAddr(addrList []string) ([]nebula.HostAddress, error) {
@@ -101,14 +162,26 @@ func parseSessionOptions(conf *Config) []nebula.SessionPoolConfOption {
 
 func (db *DB) getInstance() *DB {
 	if db.clone > 0 {
-		tx := &DB{conf: db.conf, sessionPool: db.sessionPool, clone: 0}
-		tx.Statement = statement.New()
+		tx := &DB{conf: db.conf, router: db.router, resolver: db.resolver, dryRun: db.dryRun, recorder: db.recorder, clone: 0}
+		tx.Statement = statement.New(statement.WithResolver(db.resolver))
 		return tx
 	}
 	return db
 }
 
+// Cluster the statement will be executed on the specified cluster, regardless of whether it is a read or write
+// statement, the name is ClusterPrimary or the name in Config.Clusters
+func (db *DB) Cluster(name string) (tx *DB) {
+	tx = db.getInstance()
+	tx.cluster = name
+	return
+}
+
+// Close the pools of all the clusters
 func (db *DB) Close() error {
-	db.sessionPool.Close()
+	if db.router == nil {
+		return nil
+	}
+	db.router.close()
 	return nil
 }
@@ -0,0 +1,12 @@
+package nebulaorm
+
+import "github.com/haysons/nebulaorm/resolver"
+
+// nullable types, see more information on the types of the same name in resolver
+type (
+	NullString  = resolver.NullString
+	NullInt64   = resolver.NullInt64
+	NullFloat64 = resolver.NullFloat64
+	NullBool    = resolver.NullBool
+	NullTime    = resolver.NullTime
+)
@@ -0,0 +1,88 @@
+package resolver
+
+import (
+	"reflect"
+	"sync"
+)
+
+// the parsed schemas are cached process-wide, keyed by the struct type, the schema is read-only after parsing, so it
+// can be shared by all the clause builders and resolvers concurrently
+var (
+	vertexSchemaCache sync.Map // map[reflect.Type]*VertexSchema
+	edgeSchemaCache   sync.Map // map[reflect.Type]*EdgeSchema
+	recordSchemaCache sync.Map // map[reflect.Type]*RecordSchema
+	structPropsCache  sync.Map // map[reflect.Type][]*Prop
+)
+
+// ParseVertex parse vertex struct, the result is cached by the struct type
+func ParseVertex(destType reflect.Type) (*VertexSchema, error) {
+	destType = indirectType(destType)
+	if s, ok := vertexSchemaCache.Load(destType); ok {
+		return s.(*VertexSchema), nil
+	}
+	vertexSchema, err := parseVertex(destType)
+	if err != nil {
+		return nil, err
+	}
+	s, _ := vertexSchemaCache.LoadOrStore(destType, vertexSchema)
+	return s.(*VertexSchema), nil
+}
+
+// ParseEdge parse edge struct, the result is cached by the struct type
+func ParseEdge(destType reflect.Type) (*EdgeSchema, error) {
+	destType = indirectType(destType)
+	if s, ok := edgeSchemaCache.Load(destType); ok {
+		return s.(*EdgeSchema), nil
+	}
+	edgeSchema, err := parseEdge(destType)
+	if err != nil {
+		return nil, err
+	}
+	s, _ := edgeSchemaCache.LoadOrStore(destType, edgeSchema)
+	return s.(*EdgeSchema), nil
+}
+
+// ParseRecord parse the struct used to receive records, the result is cached by the struct type
+func ParseRecord(destType reflect.Type) (*RecordSchema, error) {
+	destType = indirectType(destType)
+	if s, ok := recordSchemaCache.Load(destType); ok {
+		return s.(*RecordSchema), nil
+	}
+	recordSchema, err := parseRecord(destType)
+	if err != nil {
+		return nil, err
+	}
+	s, _ := recordSchemaCache.LoadOrStore(destType, recordSchema)
+	return s.(*RecordSchema), nil
+}
+
+// ParseStructProps parse the exported fields of the struct as properties, the index of the property in the result is
+// the same as the index of the field, and the property is nil for embedded and unexported fields. the result is cached
+// by the struct type.
+func ParseStructProps(destType reflect.Type) ([]*Prop, error) {
+	destType = indirectType(destType)
+	if s, ok := structPropsCache.Load(destType); ok {
+		return s.([]*Prop), nil
+	}
+	props := make([]*Prop, destType.NumField())
+	for i := 0; i < destType.NumField(); i++ {
+		structField := destType.Field(i)
+		if structField.Anonymous || !structField.IsExported() {
+			continue
+		}
+		prop, err := ParseProp(structField)
+		if err != nil {
+			return nil, err
+		}
+		props[i] = prop
+	}
+	s, _ := structPropsCache.LoadOrStore(destType, props)
+	return s.([]*Prop), nil
+}
+
+func indirectType(destType reflect.Type) reflect.Type {
+	if destType.Kind() == reflect.Ptr {
+		return destType.Elem()
+	}
+	return destType
+}
@@ -0,0 +1,106 @@
+package resolver
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestSchemaCache(t *testing.T) {
+	s1, err := ParseVertex(reflect.TypeOf(vertex1{}))
+	if err != nil {
+		t.Fatalf("ParseVertex() error = %v", err)
+	}
+	s2, _ := ParseVertex(reflect.TypeOf(&vertex1{}))
+	if s1 != s2 {
+		t.Errorf("ParseVertex() should return the cached schema for the struct and the struct pointer")
+	}
+	e1, err := ParseEdge(reflect.TypeOf(edge1{}))
+	if err != nil {
+		t.Fatalf("ParseEdge() error = %v", err)
+	}
+	e2, _ := ParseEdge(reflect.TypeOf(&edge1{}))
+	if e1 != e2 {
+		t.Errorf("ParseEdge() should return the cached schema")
+	}
+
+	// anonymous structs have the same PkgPath and Name, but they are different types
+	r1, _ := ParseRecord(reflect.TypeOf(struct {
+		A int `norm:"col:a"`
+	}{}))
+	r2, _ := ParseRecord(reflect.TypeOf(struct {
+		B int `norm:"col:b"`
+	}{}))
+	if len(r1.GetFieldIndexByColName("a")) == 0 || len(r2.GetFieldIndexByColName("b")) == 0 {
+		t.Errorf("ParseRecord() should not share schema between different anonymous structs")
+	}
+}
+
+func TestSchemaCacheConcurrent(t *testing.T) {
+	type record3 struct {
+		Name string `norm:"col:name"`
+	}
+	var wg sync.WaitGroup
+	schemas := make([]*RecordSchema, 16)
+	for i := range schemas {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			schemas[i], _ = ParseRecord(reflect.TypeOf(record3{}))
+		}(i)
+	}
+	wg.Wait()
+	for _, s := range schemas {
+		if s != schemas[0] {
+			t.Errorf("ParseRecord() should return the same schema concurrently")
+		}
+	}
+}
+
+func BenchmarkParseVertex(b *testing.B) {
+	vertexType := reflect.TypeOf(vertex1{})
+	b.Run("cached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = ParseVertex(vertexType)
+		}
+	})
+	b.Run("uncached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = parseVertex(vertexType)
+		}
+	})
+}
+
+func BenchmarkParseEdge(b *testing.B) {
+	edgeType := reflect.TypeOf(edge1{})
+	b.Run("cached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = ParseEdge(edgeType)
+		}
+	})
+	b.Run("uncached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = parseEdge(edgeType)
+		}
+	})
+}
+
+func BenchmarkParseRecord(b *testing.B) {
+	recordType := reflect.TypeOf(record1{})
+	b.Run("cached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = ParseRecord(recordType)
+		}
+	})
+	b.Run("uncached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = parseRecord(recordType)
+		}
+	})
+}
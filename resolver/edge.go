@@ -24,8 +24,7 @@ type EdgeSchema struct {
 	propByName       map[string]*Prop
 }
 
-// ParseEdge parse edge struct
-func ParseEdge(destType reflect.Type) (*EdgeSchema, error) {
+func parseEdge(destType reflect.Type) (*EdgeSchema, error) {
 	if destType.Kind() == reflect.Ptr {
 		destType = destType.Elem()
 	}
@@ -87,19 +86,15 @@ func ParseEdge(destType reflect.Type) (*EdgeSchema, error) {
 			continue
 		}
 		// parsing Edge Properties
-		propName := GetPropName(field)
-		nebulaType := GetValueNebulaType(field)
-		prop := &Prop{
-			Name:        propName,
-			StructField: field,
-			Type:        field.Type,
-			NebulaType:  nebulaType,
+		prop, err := ParseProp(field)
+		if err != nil {
+			return nil, err
 		}
-		if _, ok = edge.propByName[propName]; ok {
+		if _, ok = edge.propByName[prop.Name]; ok {
 			continue
 		}
 		edge.props = append(edge.props, prop)
-		edge.propByName[propName] = prop
+		edge.propByName[prop.Name] = prop
 	}
 	if edge.srcVIDFieldIndex < 0 || edge.dstVIDFieldIndex < 0 {
 		return nil, errors.New("nebulaorm: parse edge failed, edge must contains src_id field and dst_id field")
@@ -129,6 +124,9 @@ func (e *EdgeSchema) GetSrcVIDExpr(edgeValue reflect.Value) string {
 	}
 	switch e.srcVIDType {
 	case VIDTypeString:
+		if srcID.(string) == "" {
+			return ""
+		}
 		return strconv.Quote(srcID.(string))
 	case VIDTypeInt64:
 		return strconv.FormatInt(srcID.(int64), 10)
@@ -153,6 +151,9 @@ func (e *EdgeSchema) GetDstVIDExpr(edgeValue reflect.Value) string {
 	}
 	switch e.dstVIDType {
 	case VIDTypeString:
+		if dstID.(string) == "" {
+			return ""
+		}
 		return strconv.Quote(dstID.(string))
 	case VIDTypeInt64:
 		return strconv.FormatInt(dstID.(int64), 10)
@@ -175,20 +176,20 @@ func (e *EdgeSchema) GetProps() []*Prop {
 }
 
 // Scan assign a value to a target struct
-func (e *EdgeSchema) Scan(rl *nebula.Relationship, destValue reflect.Value) error {
+func (e *EdgeSchema) Scan(r *Resolver, rl *nebula.Relationship, destValue reflect.Value) error {
 	destValue = reflect.Indirect(destValue)
 	if !destValue.CanSet() {
 		return fmt.Errorf("nebulaorm: edge schema scan dest value failed, %w", ErrValueCannotSet)
 	}
 	if e.srcVIDFieldIndex >= 0 {
 		srcID := rl.GetSrcVertexID()
-		if err := ScanSimpleValue(&srcID, destValue.Field(e.srcVIDFieldIndex)); err != nil {
+		if err := r.ScanSimpleValue(&srcID, destValue.Field(e.srcVIDFieldIndex)); err != nil {
 			return err
 		}
 	}
 	if e.dstVIDFieldIndex >= 0 {
 		dstID := rl.GetDstVertexID()
-		if err := ScanSimpleValue(&dstID, destValue.Field(e.dstVIDFieldIndex)); err != nil {
+		if err := r.ScanSimpleValue(&dstID, destValue.Field(e.dstVIDFieldIndex)); err != nil {
 			return err
 		}
 	}
@@ -201,7 +202,7 @@ func (e *EdgeSchema) Scan(rl *nebula.Relationship, destValue reflect.Value) erro
 		if !ok {
 			continue
 		}
-		if err := ScanSimpleValue(propValue, destValue.FieldByIndex(eProp.StructField.Index)); err != nil {
+		if err := eProp.ScanValue(r, propValue, destValue.FieldByIndex(eProp.StructField.Index)); err != nil {
 			return err
 		}
 	}
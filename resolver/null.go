@@ -0,0 +1,153 @@
+package resolver
+
+import (
+	"fmt"
+	"time"
+)
+
+// Valuer the type that implements this interface converts itself into the value saved to nebula graph, returning nil
+// means the value is NULL.
+type Valuer interface {
+	NebulaValue() interface{}
+}
+
+// Scanner the type that implements this interface assigns the value returned by nebula graph to itself, the value
+// is nil if nebula graph returns NULL. see GetValueIface for the type of the value.
+type Scanner interface {
+	ScanNebula(value interface{}) error
+}
+
+// NullString represents a string that may be NULL.
+// when updating through a struct, the zero value of NullString is not updated, NullString{Valid: true} updates the
+// property to "", and a non-nil *NullString whose Valid is false updates the property to NULL.
+type NullString struct {
+	String string
+	Valid  bool
+}
+
+func (n NullString) NebulaValue() interface{} {
+	if !n.Valid {
+		return nil
+	}
+	return n.String
+}
+
+func (n *NullString) ScanNebula(value interface{}) error {
+	if value == nil {
+		n.String, n.Valid = "", false
+		return nil
+	}
+	v, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("nebulaorm: can not scan %T into NullString", value)
+	}
+	n.String, n.Valid = v, true
+	return nil
+}
+
+// NullInt64 represents an int64 that may be NULL, its update semantics are the same as NullString.
+type NullInt64 struct {
+	Int64 int64
+	Valid bool
+}
+
+func (n NullInt64) NebulaValue() interface{} {
+	if !n.Valid {
+		return nil
+	}
+	return n.Int64
+}
+
+func (n *NullInt64) ScanNebula(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		n.Int64, n.Valid = 0, false
+	case int64:
+		n.Int64, n.Valid = v, true
+	case float64:
+		n.Int64, n.Valid = int64(v), true
+	default:
+		return fmt.Errorf("nebulaorm: can not scan %T into NullInt64", value)
+	}
+	return nil
+}
+
+// NullFloat64 represents a float64 that may be NULL, its update semantics are the same as NullString.
+type NullFloat64 struct {
+	Float64 float64
+	Valid   bool
+}
+
+func (n NullFloat64) NebulaValue() interface{} {
+	if !n.Valid {
+		return nil
+	}
+	return n.Float64
+}
+
+func (n *NullFloat64) ScanNebula(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		n.Float64, n.Valid = 0, false
+	case float64:
+		n.Float64, n.Valid = v, true
+	case int64:
+		n.Float64, n.Valid = float64(v), true
+	default:
+		return fmt.Errorf("nebulaorm: can not scan %T into NullFloat64", value)
+	}
+	return nil
+}
+
+// NullBool represents a bool that may be NULL, its update semantics are the same as NullString.
+type NullBool struct {
+	Bool  bool
+	Valid bool
+}
+
+func (n NullBool) NebulaValue() interface{} {
+	if !n.Valid {
+		return nil
+	}
+	return n.Bool
+}
+
+func (n *NullBool) ScanNebula(value interface{}) error {
+	if value == nil {
+		n.Bool, n.Valid = false, false
+		return nil
+	}
+	v, ok := value.(bool)
+	if !ok {
+		return fmt.Errorf("nebulaorm: can not scan %T into NullBool", value)
+	}
+	n.Bool, n.Valid = v, true
+	return nil
+}
+
+// NullTime represents a time.Time that may be NULL, it can be used for date and datetime properties, and its update
+// semantics are the same as NullString.
+type NullTime struct {
+	Time  time.Time
+	Valid bool
+}
+
+func (n NullTime) NebulaValue() interface{} {
+	if !n.Valid {
+		return nil
+	}
+	return n.Time
+}
+
+func (n *NullTime) ScanNebula(value interface{}) error {
+	if value == nil {
+		n.Time, n.Valid = time.Time{}, false
+		return nil
+	}
+	v, ok := value.(time.Time)
+	if !ok {
+		return fmt.Errorf("nebulaorm: can not scan %T into NullTime", value)
+	}
+	n.Time, n.Valid = v, true
+	return nil
+}
@@ -0,0 +1,72 @@
+package resolver
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFormatNullValue(t *testing.T) {
+	tests := []struct {
+		nebulaType string
+		value      interface{}
+		want       string
+	}{
+		{value: NullString{}, want: "NULL"},
+		{value: NullString{String: "hello", Valid: true}, want: `"hello"`},
+		{value: &NullString{String: "", Valid: true}, want: `""`},
+		{value: (*NullString)(nil), want: "NULL"},
+		{value: NullInt64{Int64: 0, Valid: true}, want: "0"},
+		{value: NullInt64{Int64: 10}, want: "NULL"},
+		{value: NullFloat64{Float64: 1.5, Valid: true}, want: "1.5"},
+		{value: NullBool{Bool: false, Valid: true}, want: "false"},
+		{value: NullTime{Time: time.Date(2024, 8, 20, 11, 16, 30, 0, time.Local), Valid: true}, want: `datetime("2024-08-20T11:16:30")`},
+		{nebulaType: NebulaDataTypeDate, value: NullTime{Time: time.Date(2024, 8, 20, 11, 16, 30, 0, time.Local), Valid: true}, want: `date("2024-08-20")`},
+		{nebulaType: NebulaDataTypeDate, value: NullTime{}, want: "NULL"},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			got, err := FormatSimpleValue(tt.nebulaType, reflect.ValueOf(tt.value))
+			if err != nil {
+				t.Errorf("FormatSimpleValue() error = %v", err)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("FormatSimpleValue() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScanNullValue(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		dest    Scanner
+		value   interface{}
+		want    interface{}
+		wantErr bool
+	}{
+		{dest: &NullString{String: "a", Valid: true}, value: nil, want: &NullString{}},
+		{dest: &NullString{}, value: "a", want: &NullString{String: "a", Valid: true}},
+		{dest: &NullString{}, value: int64(1), wantErr: true},
+		{dest: &NullInt64{}, value: int64(0), want: &NullInt64{Valid: true}},
+		{dest: &NullInt64{}, value: 2.0, want: &NullInt64{Int64: 2, Valid: true}},
+		{dest: &NullFloat64{}, value: int64(2), want: &NullFloat64{Float64: 2, Valid: true}},
+		{dest: &NullBool{}, value: true, want: &NullBool{Bool: true, Valid: true}},
+		{dest: &NullTime{}, value: now, want: &NullTime{Time: now, Valid: true}},
+		{dest: &NullTime{}, value: "2024-08-20", wantErr: true},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			err := tt.dest.ScanNebula(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ScanNebula() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && !reflect.DeepEqual(tt.dest, tt.want) {
+				t.Errorf("ScanNebula() got = %+v, want %+v", tt.dest, tt.want)
+			}
+		})
+	}
+}
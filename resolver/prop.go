@@ -0,0 +1,204 @@
+package resolver
+
+import (
+	"fmt"
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AutoTimeUnit the unit of the current time filled into the property, the unit only makes sense for integer properties
+type AutoTimeUnit int
+
+const (
+	AutoTimeNone AutoTimeUnit = iota
+	AutoTimeSecond
+	AutoTimeMilli
+	AutoTimeNano
+)
+
+// Prop the property of vertex tag or edge, which is parsed from the struct field
+type Prop struct {
+	Name           string
+	StructField    reflect.StructField
+	Type           reflect.Type
+	NebulaType     string
+	Serializer     Serializer
+	Default        string
+	AutoCreateTime AutoTimeUnit
+	AutoUpdateTime AutoTimeUnit
+	NotNull        bool
+	SchemaType     string
+	Setting        map[string]string // all the settings declared in the tag
+	defaultValue   reflect.Value
+}
+
+// ParseProp parse the struct field as a property of vertex tag or edge
+func ParseProp(field reflect.StructField) (*Prop, error) {
+	serializer, err := GetValueSerializer(field)
+	if err != nil {
+		return nil, err
+	}
+	setting := ParseTagSetting(field.Tag.Get(TagSettingKey))
+	prop := &Prop{
+		Name:        GetPropName(field),
+		StructField: field,
+		Type:        field.Type,
+		NebulaType:  GetValueNebulaType(field),
+		Serializer:  serializer,
+		Default:     setting[TagSettingDefault],
+		SchemaType:  strings.ToLower(strings.TrimSpace(setting[TagSettingSchemaType])),
+		Setting:     setting,
+	}
+	_, prop.NotNull = setting[TagSettingNotNull]
+	if prop.Default != "" {
+		if prop.defaultValue, err = parseDefaultValue(field.Type, prop.Default); err != nil {
+			return nil, fmt.Errorf("nebulaorm: parse default value of field %s failed, %w", field.Name, err)
+		}
+	}
+	if prop.AutoCreateTime, err = parseAutoTime(field, setting, TagSettingCreateTime); err != nil {
+		return nil, err
+	}
+	if prop.AutoUpdateTime, err = parseAutoTime(field, setting, TagSettingUpdateTime); err != nil {
+		return nil, err
+	}
+	return prop, nil
+}
+
+// FormatValue format the value of the property to nebula graph data format, if the property specifies a serializer,
+// the value will be serialized into a string.
+func (p *Prop) FormatValue(value reflect.Value) (string, error) {
+	if p.Serializer != nil {
+		return FormatSerializedValue(p.Serializer, value)
+	}
+	return FormatSimpleValue(p.NebulaType, value)
+}
+
+// FormatDefault format the default value of the property, a default value that cannot be converted to the type of
+// the field is treated as a nGQL expression, eg: `norm:"default:datetime()"`
+func (p *Prop) FormatDefault() (string, error) {
+	if !p.defaultValue.IsValid() {
+		return p.Default, nil
+	}
+	return p.FormatValue(p.defaultValue)
+}
+
+// AutoTimeValue convert the current time into the value of the property according to the type of the field
+func (p *Prop) AutoTimeValue(now time.Time, unit AutoTimeUnit) reflect.Value {
+	value := reflect.New(p.Type).Elem()
+	elem := value
+	for elem.Kind() == reflect.Ptr {
+		elem.Set(reflect.New(elem.Type().Elem()))
+		elem = elem.Elem()
+	}
+	switch elem.Kind() {
+	case reflect.Int, reflect.Int64:
+		switch unit {
+		case AutoTimeMilli:
+			elem.SetInt(now.UnixNano() / int64(time.Millisecond))
+		case AutoTimeNano:
+			elem.SetInt(now.UnixNano())
+		default:
+			elem.SetInt(now.Unix())
+		}
+	case reflect.String:
+		switch p.NebulaType {
+		case NebulaDataTypeDate:
+			elem.SetString(now.Format("2006-01-02"))
+		case NebulaDataTypeTime:
+			elem.SetString(now.Format("15:04:05.000000"))
+		default:
+			elem.SetString(now.Format("2006-01-02T15:04:05.000000"))
+		}
+	case reflect.Struct:
+		switch elem.Interface().(type) {
+		case time.Time:
+			elem.Set(reflect.ValueOf(now))
+		case NullTime:
+			elem.Set(reflect.ValueOf(NullTime{Time: now, Valid: true}))
+		}
+	}
+	return value
+}
+
+// ScanValue assign the property value returned by nebula graph to dest value, if the property specifies a serializer,
+// the string value will be deserialized into dest value.
+func (p *Prop) ScanValue(r *Resolver, nebulaValue *nebula.ValueWrapper, destValue reflect.Value) error {
+	if p.Serializer != nil {
+		return ScanSerializedValue(p.Serializer, nebulaValue, destValue)
+	}
+	return r.ScanSimpleValue(nebulaValue, destValue)
+}
+
+func parseDefaultValue(fieldType reflect.Type, s string) (reflect.Value, error) {
+	value := reflect.New(fieldType).Elem()
+	elem := value
+	for elem.Kind() == reflect.Ptr {
+		elem.Set(reflect.New(elem.Type().Elem()))
+		elem = elem.Elem()
+	}
+	switch elem.Kind() {
+	case reflect.String:
+		elem.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		elem.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, elem.Type().Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		elem.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, elem.Type().Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		elem.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, elem.Type().Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		elem.SetFloat(f)
+	default:
+		// other types of default values are written to the statement as they are
+		return reflect.Value{}, nil
+	}
+	return value, nil
+}
+
+func parseAutoTime(field reflect.StructField, setting map[string]string, key string) (AutoTimeUnit, error) {
+	unit, ok := setting[key]
+	if !ok {
+		return AutoTimeNone, nil
+	}
+	fieldType := field.Type
+	for fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	switch fieldType.Kind() {
+	case reflect.Int, reflect.Int64, reflect.String:
+	case reflect.Struct:
+		if fieldType != reflect.TypeOf(time.Time{}) && fieldType != reflect.TypeOf(NullTime{}) {
+			return AutoTimeNone, fmt.Errorf("nebulaorm: field %s with %s should be time.Time, int64 or string", field.Name, key)
+		}
+	default:
+		return AutoTimeNone, fmt.Errorf("nebulaorm: field %s with %s should be time.Time, int64 or string", field.Name, key)
+	}
+	switch unit {
+	case key:
+		return AutoTimeSecond, nil
+	case "milli":
+		return AutoTimeMilli, nil
+	case "nano":
+		return AutoTimeNano, nil
+	default:
+		return AutoTimeNone, fmt.Errorf("nebulaorm: unknown unit %s of %s, only milli and nano are supported", unit, key)
+	}
+}
@@ -0,0 +1,103 @@
+package resolver
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPropDefault(t *testing.T) {
+	type propStruct struct {
+		Name    string    `norm:"default:unknown"`
+		Age     int8      `norm:"default:18"`
+		Score   *float64  `norm:"default:1.5"`
+		Created time.Time `norm:"default:datetime()"`
+		Date    string    `norm:"datatype:date;default:2024-01-01"`
+		Invalid int       `norm:"default:abc"`
+	}
+	propType := reflect.TypeOf(propStruct{})
+	tests := []struct {
+		field   int
+		want    string
+		wantErr bool
+	}{
+		{field: 0, want: `"unknown"`},
+		{field: 1, want: `18`},
+		{field: 2, want: `1.5`},
+		{field: 3, want: `datetime()`},
+		{field: 4, want: `date("2024-01-01")`},
+		{field: 5, wantErr: true},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			prop, err := ParseProp(propType.Field(tt.field))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseProp() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			got, err := prop.FormatDefault()
+			if err != nil {
+				t.Errorf("FormatDefault() error = %v", err)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("FormatDefault() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPropAutoTimeValue(t *testing.T) {
+	type propStruct struct {
+		Created    time.Time  `norm:"autoCreateTime"`
+		CreatedPtr *time.Time `norm:"autoCreateTime"`
+		Updated    int64      `norm:"autoUpdateTime"`
+		UpdatedMs  int64      `norm:"autoUpdateTime:milli"`
+		UpdatedStr string     `norm:"autoUpdateTime"`
+		UpdatedDay string     `norm:"autoUpdateTime;datatype:date"`
+		UpdatedNul NullTime   `norm:"autoUpdateTime"`
+		Invalid    bool       `norm:"autoUpdateTime"`
+		InvalidU   int64      `norm:"autoUpdateTime:day"`
+	}
+	now := time.Date(2024, 8, 20, 11, 16, 30, 10000, time.Local)
+	propType := reflect.TypeOf(propStruct{})
+	tests := []struct {
+		field   int
+		want    interface{}
+		wantErr bool
+	}{
+		{field: 0, want: now},
+		{field: 1, want: &now},
+		{field: 2, want: now.Unix()},
+		{field: 3, want: now.UnixNano() / int64(time.Millisecond)},
+		{field: 4, want: "2024-08-20T11:16:30.000010"},
+		{field: 5, want: "2024-08-20"},
+		{field: 6, want: NullTime{Time: now, Valid: true}},
+		{field: 7, wantErr: true},
+		{field: 8, wantErr: true},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			prop, err := ParseProp(propType.Field(tt.field))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseProp() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			unit := prop.AutoCreateTime
+			if unit == AutoTimeNone {
+				unit = prop.AutoUpdateTime
+			}
+			got := prop.AutoTimeValue(now, unit).Interface()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AutoTimeValue() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
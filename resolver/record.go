@@ -9,10 +9,19 @@ import (
 // object returned by the nebula graph to the business layer
 type RecordSchema struct {
 	Name          string
+	cols          []*RecordCol
 	colFieldIndex map[string][]int
+	colSerializer map[string]Serializer
 }
 
-func ParseRecord(destType reflect.Type) (*RecordSchema, error) {
+// RecordCol a column of the record, in the order of the fields
+type RecordCol struct {
+	Name  string // name of the column
+	Field reflect.StructField
+	Yield string // the expression yielded as the column, specified by the yield setting, eg: norm:"col:team;yield:properties($$).name"
+}
+
+func parseRecord(destType reflect.Type) (*RecordSchema, error) {
 	if destType.Kind() == reflect.Ptr {
 		destType = destType.Elem()
 	}
@@ -33,15 +42,40 @@ func ParseRecord(destType reflect.Type) (*RecordSchema, error) {
 		}
 		colName := getColName(structField)
 		record.colFieldIndex[colName] = []int{i}
+		record.cols = append(record.cols, &RecordCol{
+			Name:  colName,
+			Field: structField,
+			Yield: ParseTagSetting(structField.Tag.Get(TagSettingKey))[TagSettingYield],
+		})
+		serializer, err := GetValueSerializer(structField)
+		if err != nil {
+			return nil, err
+		}
+		if serializer != nil {
+			if record.colSerializer == nil {
+				record.colSerializer = make(map[string]Serializer)
+			}
+			record.colSerializer[colName] = serializer
+		}
 	}
 	return record, nil
 }
 
+// GetCols get the columns of the record in the order of the fields
+func (r *RecordSchema) GetCols() []*RecordCol {
+	return r.cols
+}
+
 // GetFieldIndexByColName get the index position of a field
 func (r *RecordSchema) GetFieldIndexByColName(colName string) []int {
 	return r.colFieldIndex[colName]
 }
 
+// GetSerializerByColName get the serializer of a field, return nil if the field does not specify a serializer
+func (r *RecordSchema) GetSerializerByColName(colName string) Serializer {
+	return r.colSerializer[colName]
+}
+
 func getColName(field reflect.StructField) string {
 	setting := ParseTagSetting(field.Tag.Get(TagSettingKey))
 	colName := setting[TagSettingColName]
@@ -14,11 +14,26 @@ func TestParseRecord(t *testing.T) {
 	}{
 		{
 			record: record1{},
-			want:   &RecordSchema{Name: "record1", colFieldIndex: map[string][]int{"name": {0}, "age": {1}, "c": {3}}},
+			want: &RecordSchema{
+				Name: "record1",
+				cols: []*RecordCol{
+					{Name: "name", Field: reflect.TypeOf(record1{}).Field(0)},
+					{Name: "age", Field: reflect.TypeOf(record1{}).Field(1)},
+					{Name: "c", Field: reflect.TypeOf(record1{}).Field(3)},
+				},
+				colFieldIndex: map[string][]int{"name": {0}, "age": {1}, "c": {3}},
+			},
 		},
 		{
 			record: record2{},
-			want:   &RecordSchema{Name: "record2", colFieldIndex: map[string][]int{"col1": {1}, "names": {2}}},
+			want: &RecordSchema{
+				Name: "record2",
+				cols: []*RecordCol{
+					{Name: "col1", Field: reflect.TypeOf(record2{}).Field(1)},
+					{Name: "names", Field: reflect.TypeOf(record2{}).Field(2), Yield: "properties(vertex).names"},
+				},
+				colFieldIndex: map[string][]int{"col1": {1}, "names": {2}},
+			},
 		},
 	}
 	for i, tt := range tests {
@@ -47,5 +62,5 @@ type record1 struct {
 type record2 struct {
 	record1
 	Col1  *record1 `norm:"col:col1"`
-	Names []string `norm:"col:names"`
+	Names []string `norm:"col:names;yield:properties(vertex).names"`
 }
@@ -31,19 +31,48 @@ var (
 	ErrValueCannotSet = errors.New("reflect value can not be set")
 )
 
-// Resolver responsible for parsing and converting data types in nebula graph and defined data types in golang
+// Resolver responsible for parsing and converting data types in nebula graph and defined data types in golang,
+// the parsed schemas are shared by all resolvers, see ParseVertex ParseEdge and ParseRecord. The conversion settings
+// such as timezone are owned by each resolver, so that multiple nebula graph servers in different timezones can be
+// used at the same time.
 type Resolver struct {
-	vertexSchema map[string]*VertexSchema
-	edgeSchema   map[string]*EdgeSchema
-	recordSchema map[string]*RecordSchema
+	timezone *time.Location
 }
 
-func NewResolver() *Resolver {
-	return &Resolver{
-		vertexSchema: make(map[string]*VertexSchema),
-		edgeSchema:   make(map[string]*EdgeSchema),
-		recordSchema: make(map[string]*RecordSchema),
+// Option the conversion setting of the resolver
+type Option func(*Resolver)
+
+// WithTimezone the timezone of the nebula graph server, which is used to convert date and datetime, default is Local
+func WithTimezone(loc *time.Location) Option {
+	return func(r *Resolver) {
+		if loc != nil {
+			r.timezone = loc
+		}
+	}
+}
+
+func NewResolver(opts ...Option) *Resolver {
+	r := &Resolver{timezone: time.Local}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// defaultResolver is used by the package level functions and the nil resolver
+var defaultResolver = NewResolver()
+
+// Timezone get the timezone of the resolver, the default resolver is used if r is nil
+func (r *Resolver) Timezone() *time.Location {
+	if r == nil {
+		r = defaultResolver
 	}
+	return r.timezone
+}
+
+// Now get the current time in the timezone of the resolver
+func (r *Resolver) Now() time.Time {
+	return time.Now().In(r.Timezone())
 }
 
 // ScanValue scan nebula graph value into dest value.
@@ -58,11 +87,11 @@ func (r *Resolver) ScanValue(nebulaValue *nebula.ValueWrapper, destValue reflect
 		switch destValue.Kind() {
 		case reflect.Struct:
 			destType := destValue.Type()
-			vertexSchema, err := r.getVertexSchema(destType)
+			vertexSchema, err := ParseVertex(destType)
 			if err != nil {
 				return err
 			}
-			return vertexSchema.Scan(vNode, destValue)
+			return vertexSchema.Scan(r, vNode, destValue)
 		default:
 		}
 	case NebulaDataTypeEdge:
@@ -71,11 +100,11 @@ func (r *Resolver) ScanValue(nebulaValue *nebula.ValueWrapper, destValue reflect
 		switch destValue.Kind() {
 		case reflect.Struct:
 			destType := destValue.Type()
-			edgeSchema, err := r.getEdgeSchema(destType)
+			edgeSchema, err := ParseEdge(destType)
 			if err != nil {
 				return err
 			}
-			return edgeSchema.Scan(vRelationShip, destValue)
+			return edgeSchema.Scan(r, vRelationShip, destValue)
 		default:
 		}
 	case NebulaDataTypeList:
@@ -145,7 +174,7 @@ func (r *Resolver) ScanValue(nebulaValue *nebula.ValueWrapper, destValue reflect
 		default:
 		}
 	default:
-		return ScanSimpleValue(nebulaValue, destValue)
+		return r.ScanSimpleValue(nebulaValue, destValue)
 	}
 	return fmt.Errorf("nebulaorm: can not scan nebula type %s into golang type %v", nebulaValue.GetType(), destValue.Type())
 }
@@ -157,7 +186,7 @@ func (r *Resolver) ScanRecord(record *nebula.Record, colNames []string, destValu
 		return errors.New("nebulaorm: scan record failed, dest should be a struct or a struct pointer")
 	}
 	destType := destValue.Type()
-	recordSchema, err := r.getRecordSchema(destType)
+	recordSchema, err := ParseRecord(destType)
 	if err != nil {
 		return err
 	}
@@ -171,6 +200,12 @@ func (r *Resolver) ScanRecord(record *nebula.Record, colNames []string, destValu
 			continue
 		}
 		fieldValue := destValue.FieldByIndex(fieldIndex)
+		if serializer := recordSchema.GetSerializerByColName(colName); serializer != nil {
+			if err = ScanSerializedValue(serializer, colValue, fieldValue); err != nil {
+				return err
+			}
+			continue
+		}
 		if err = r.ScanValue(colValue, fieldValue); err != nil {
 			return err
 		}
@@ -178,51 +213,13 @@ func (r *Resolver) ScanRecord(record *nebula.Record, colNames []string, destValu
 	return nil
 }
 
-func (r *Resolver) getRecordSchema(destType reflect.Type) (*RecordSchema, error) {
-	key := r.getSchemaKey(destType)
-	if s, ok := r.recordSchema[key]; ok {
-		return s, nil
-	}
-	recordSchema, err := ParseRecord(destType)
-	if err != nil {
-		return nil, err
-	}
-	r.recordSchema[key] = recordSchema
-	return recordSchema, nil
-}
-
-func (r *Resolver) getVertexSchema(destType reflect.Type) (*VertexSchema, error) {
-	key := r.getSchemaKey(destType)
-	if s, ok := r.vertexSchema[key]; ok {
-		return s, nil
-	}
-	vertexSchema, err := ParseVertex(destType)
-	if err != nil {
-		return nil, err
-	}
-	r.vertexSchema[key] = vertexSchema
-	return vertexSchema, nil
-}
-
-func (r *Resolver) getEdgeSchema(destType reflect.Type) (*EdgeSchema, error) {
-	key := r.getSchemaKey(destType)
-	if e, ok := r.edgeSchema[key]; ok {
-		return e, nil
-	}
-	edgeSchema, err := ParseEdge(destType)
-	if err != nil {
-		return nil, err
-	}
-	r.edgeSchema[key] = edgeSchema
-	return edgeSchema, nil
-}
-
-func (r *Resolver) getSchemaKey(destType reflect.Type) string {
-	return destType.PkgPath() + "." + destType.Name()
+// ScanSimpleValue assign values to simple data types, using the timezone of the default resolver
+func ScanSimpleValue(nebulaValue *nebula.ValueWrapper, destValue reflect.Value) error {
+	return defaultResolver.ScanSimpleValue(nebulaValue, destValue)
 }
 
 // ScanSimpleValue assign values to simple data types
-func ScanSimpleValue(nebulaValue *nebula.ValueWrapper, destValue reflect.Value) error {
+func (r *Resolver) ScanSimpleValue(nebulaValue *nebula.ValueWrapper, destValue reflect.Value) error {
 	if !destValue.CanSet() {
 		return fmt.Errorf("nebulaorm: scan dest value failed, %w", ErrValueCannotSet)
 	}
@@ -231,8 +228,17 @@ func ScanSimpleValue(nebulaValue *nebula.ValueWrapper, destValue reflect.Value)
 		return nil
 	}
 	destValue = utils.PtrValue(destValue)
+	if destValue.CanAddr() {
+		if scanner, ok := destValue.Addr().Interface().(Scanner); ok {
+			valueIface, err := r.GetValueIface(nebulaValue)
+			if err != nil {
+				return err
+			}
+			return scanner.ScanNebula(valueIface)
+		}
+	}
 	if destValue.Kind() == reflect.Interface && destValue.NumMethod() == 0 {
-		valueIface, err := GetValueIface(nebulaValue)
+		valueIface, err := r.GetValueIface(nebulaValue)
 		if err != nil {
 			return err
 		}
@@ -293,7 +299,7 @@ func ScanSimpleValue(nebulaValue *nebula.ValueWrapper, destValue reflect.Value)
 		case reflect.String:
 			vDate, _ := nebulaValue.AsDate()
 			dateUTC := time.Date(int(vDate.GetYear()), time.Month(vDate.GetMonth()), int(vDate.GetDay()), 0, 0, 0, 0, time.UTC)
-			dateObj := dateUTC.In(timezoneDefault)
+			dateObj := dateUTC.In(r.Timezone())
 			destValue.SetString(dateObj.Format("2006-01-02"))
 			return nil
 		case reflect.Struct:
@@ -301,7 +307,7 @@ func ScanSimpleValue(nebulaValue *nebula.ValueWrapper, destValue reflect.Value)
 			if destType.PkgPath() == "time" && destType.Name() == "Time" {
 				vDate, _ := nebulaValue.AsDate()
 				dateUTC := time.Date(int(vDate.GetYear()), time.Month(vDate.GetMonth()), int(vDate.GetDay()), 0, 0, 0, 0, time.UTC)
-				dateObj := dateUTC.In(timezoneDefault)
+				dateObj := dateUTC.In(r.Timezone())
 				destValue.Set(reflect.ValueOf(dateObj))
 				return nil
 			}
@@ -311,16 +317,16 @@ func ScanSimpleValue(nebulaValue *nebula.ValueWrapper, destValue reflect.Value)
 		// todo: about conversion of time types
 	case NebulaDataTypeDatetime:
 		vDateTimeW, _ := nebulaValue.AsDateTime()
-		vDateTime, _ := vDateTimeW.GetLocalDateTimeWithTimezoneName(timezoneDefault.String())
+		vDateTime, _ := vDateTimeW.GetLocalDateTimeWithTimezoneName(r.Timezone().String())
 		switch destValue.Kind() {
 		case reflect.String:
-			dateObj := time.Date(int(vDateTime.GetYear()), time.Month(vDateTime.GetMonth()), int(vDateTime.GetDay()), int(vDateTime.GetHour()), int(vDateTime.GetMinute()), int(vDateTime.GetSec()), int(vDateTime.GetMicrosec()*1000), timezoneDefault)
+			dateObj := time.Date(int(vDateTime.GetYear()), time.Month(vDateTime.GetMonth()), int(vDateTime.GetDay()), int(vDateTime.GetHour()), int(vDateTime.GetMinute()), int(vDateTime.GetSec()), int(vDateTime.GetMicrosec()*1000), r.Timezone())
 			destValue.SetString(dateObj.Format("2006-01-02T15:04:05.000000"))
 			return nil
 		case reflect.Struct:
 			destType := destValue.Type()
 			if destType.PkgPath() == "time" && destType.Name() == "Time" {
-				dateObj := time.Date(int(vDateTime.GetYear()), time.Month(vDateTime.GetMonth()), int(vDateTime.GetDay()), int(vDateTime.GetHour()), int(vDateTime.GetMinute()), int(vDateTime.GetSec()), int(vDateTime.GetMicrosec()*1000), timezoneDefault)
+				dateObj := time.Date(int(vDateTime.GetYear()), time.Month(vDateTime.GetMonth()), int(vDateTime.GetDay()), int(vDateTime.GetHour()), int(vDateTime.GetMinute()), int(vDateTime.GetSec()), int(vDateTime.GetMicrosec()*1000), r.Timezone())
 				destValue.Set(reflect.ValueOf(dateObj))
 				return nil
 			}
@@ -332,6 +338,15 @@ func ScanSimpleValue(nebulaValue *nebula.ValueWrapper, destValue reflect.Value)
 
 // FormatSimpleValue format variable values to nebula graph data format
 func FormatSimpleValue(nebulaType string, value reflect.Value) (string, error) {
+	if value.Kind() != reflect.Ptr && value.Kind() != reflect.Interface && value.IsValid() && value.CanInterface() {
+		if valuer, ok := value.Interface().(Valuer); ok {
+			v := valuer.NebulaValue()
+			if v == nil {
+				return "NULL", nil
+			}
+			return FormatSimpleValue(nebulaType, reflect.ValueOf(v))
+		}
+	}
 	switch value.Kind() {
 	case reflect.Bool:
 		switch nebulaType {
@@ -503,8 +518,13 @@ func FormatSimpleValue(nebulaType string, value reflect.Value) (string, error) {
 	return "", fmt.Errorf("nebulaorm: format value failed, golang type: %s, nebula type: %s", value.Type(), nebulaType)
 }
 
-// GetValueIface get the nebula graph return value
+// GetValueIface get the nebula graph return value, using the timezone of the default resolver
 func GetValueIface(nebulaValue *nebula.ValueWrapper) (interface{}, error) {
+	return defaultResolver.GetValueIface(nebulaValue)
+}
+
+// GetValueIface get the nebula graph return value
+func (r *Resolver) GetValueIface(nebulaValue *nebula.ValueWrapper) (interface{}, error) {
 	switch nebulaValue.GetType() {
 	case NebulaDataTypeNull:
 		return nil, nil
@@ -519,14 +539,14 @@ func GetValueIface(nebulaValue *nebula.ValueWrapper) (interface{}, error) {
 	case NebulaDataTypeDate:
 		nDate, _ := nebulaValue.AsDate()
 		dateUTC := time.Date(int(nDate.GetYear()), time.Month(nDate.GetMonth()), int(nDate.GetDay()), 0, 0, 0, 0, time.UTC)
-		date := dateUTC.In(timezoneDefault)
+		date := dateUTC.In(r.Timezone())
 		return date, nil
 	case NebulaDataTypeTime:
 		// todo: about conversion of time types
 	case NebulaDataTypeDatetime:
 		nDatetimeW, _ := nebulaValue.AsDateTime()
-		nDatetime, _ := nDatetimeW.GetLocalDateTimeWithTimezoneName(timezoneDefault.String())
-		return time.Date(int(nDatetime.GetYear()), time.Month(nDatetime.GetMonth()), int(nDatetime.GetDay()), int(nDatetime.GetHour()), int(nDatetime.GetMinute()), int(nDatetime.GetSec()), int(nDatetime.GetMicrosec()*1000), timezoneDefault), nil
+		nDatetime, _ := nDatetimeW.GetLocalDateTimeWithTimezoneName(r.Timezone().String())
+		return time.Date(int(nDatetime.GetYear()), time.Month(nDatetime.GetMonth()), int(nDatetime.GetDay()), int(nDatetime.GetHour()), int(nDatetime.GetMinute()), int(nDatetime.GetSec()), int(nDatetime.GetMicrosec()*1000), r.Timezone()), nil
 	case NebulaDataTypeVertex:
 		return nebulaValue.AsNode()
 	case NebulaDataTypeEdge:
@@ -535,7 +555,7 @@ func GetValueIface(nebulaValue *nebula.ValueWrapper) (interface{}, error) {
 		nList, _ := nebulaValue.AsList()
 		res := make([]interface{}, 0, len(nList))
 		for _, v := range nList {
-			vIface, err := GetValueIface(&v)
+			vIface, err := r.GetValueIface(&v)
 			if err != nil {
 				return nil, err
 			}
@@ -546,7 +566,7 @@ func GetValueIface(nebulaValue *nebula.ValueWrapper) (interface{}, error) {
 		nMap, _ := nebulaValue.AsMap()
 		res := make(map[string]interface{}, len(nMap))
 		for k, v := range nMap {
-			vIface, err := GetValueIface(&v)
+			vIface, err := r.GetValueIface(&v)
 			if err != nil {
 				return nil, err
 			}
@@ -557,7 +577,7 @@ func GetValueIface(nebulaValue *nebula.ValueWrapper) (interface{}, error) {
 		nList, _ := nebulaValue.AsDedupList()
 		res := make([]interface{}, 0, len(nList))
 		for _, v := range nList {
-			vIface, err := GetValueIface(&v)
+			vIface, err := r.GetValueIface(&v)
 			if err != nil {
 				return nil, err
 			}
@@ -2,6 +2,9 @@ package resolver
 
 import (
 	"fmt"
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+	nebulatype "github.com/vesoft-inc/nebula-go/v3/nebula"
+	"github.com/vesoft-inc/nebula-go/v3/nebula/graph"
 	"reflect"
 	"testing"
 	"time"
@@ -157,3 +160,44 @@ func TestFormatSimpleValue(t *testing.T) {
 		})
 	}
 }
+
+func TestResolverTimezone(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Skipf("load timezone failed: %v", err)
+	}
+	resp := &graph.ExecutionResponse{
+		Data: &nebulatype.DataSet{
+			ColumnNames: [][]byte{[]byte("dt")},
+			Rows:        []*nebulatype.Row{{Values: []*nebulatype.Value{{DtVal: &nebulatype.DateTime{Year: 2024, Month: 1, Day: 1, Hour: 0}}}}},
+		},
+	}
+	resultSet, err := nebula.GenResultSet(resp)
+	if err != nil {
+		t.Fatalf("GenResultSet() error = %v", err)
+	}
+	record, _ := resultSet.GetRowValuesByIndex(0)
+	dtValue, _ := record.GetValueByColName("dt")
+
+	rvUTC := NewResolver(WithTimezone(time.UTC))
+	rvShanghai := NewResolver(WithTimezone(shanghai))
+	var dtUTC, dtShanghai time.Time
+	if err = rvUTC.ScanSimpleValue(dtValue, reflect.ValueOf(&dtUTC).Elem()); err != nil {
+		t.Fatalf("ScanSimpleValue() error = %v", err)
+	}
+	if err = rvShanghai.ScanSimpleValue(dtValue, reflect.ValueOf(&dtShanghai).Elem()); err != nil {
+		t.Fatalf("ScanSimpleValue() error = %v", err)
+	}
+	if dtUTC.Hour() != 0 || dtUTC.Location() != time.UTC {
+		t.Errorf("ScanSimpleValue() got = %v, want 2024-01-01 00:00:00 UTC", dtUTC)
+	}
+	if dtShanghai.Hour() != 8 || dtShanghai.Location() != shanghai {
+		t.Errorf("ScanSimpleValue() got = %v, want 2024-01-01 08:00:00 CST", dtShanghai)
+	}
+	if !dtUTC.Equal(dtShanghai) {
+		t.Errorf("the same datetime should be the same instant in different timezones")
+	}
+	if got := rvShanghai.Now().Location(); got != shanghai {
+		t.Errorf("Now() got location %v, want %v", got, shanghai)
+	}
+}
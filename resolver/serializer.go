@@ -0,0 +1,122 @@
+package resolver
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	SerializerJSON = "json"
+	SerializerGob  = "gob"
+)
+
+// Serializer converts a golang value to the string stored in the nebula graph property and back again. the field of
+// the struct specifies the serializer by name through the tag, eg: `norm:"prop:config;serializer:json"`
+type Serializer interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+var (
+	serializerMu sync.RWMutex
+	serializers  = map[string]Serializer{
+		SerializerJSON: JSONSerializer{},
+		SerializerGob:  GobSerializer{},
+	}
+)
+
+// RegisterSerializer register a serializer with the given name, a serializer with the same name will be overwritten.
+// the name is case-insensitive, it is recommended to register the serializer in the init function.
+func RegisterSerializer(name string, serializer Serializer) {
+	serializerMu.Lock()
+	defer serializerMu.Unlock()
+	serializers[strings.ToLower(name)] = serializer
+}
+
+// GetSerializer get the serializer registered with the given name
+func GetSerializer(name string) (Serializer, bool) {
+	serializerMu.RLock()
+	defer serializerMu.RUnlock()
+	s, ok := serializers[strings.ToLower(name)]
+	return s, ok
+}
+
+// JSONSerializer serialize the value using encoding/json
+type JSONSerializer struct{}
+
+func (JSONSerializer) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (JSONSerializer) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+// GobSerializer serialize the value using encoding/gob, the binary data is encoded in base64 so that it can be saved
+// in a string property.
+type GobSerializer struct{}
+
+func (GobSerializer) Marshal(v interface{}) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := gob.NewEncoder(buf).Encode(v); err != nil {
+		return nil, err
+	}
+	res := make([]byte, base64.StdEncoding.EncodedLen(buf.Len()))
+	base64.StdEncoding.Encode(res, buf.Bytes())
+	return res, nil
+}
+
+func (GobSerializer) Unmarshal(data []byte, v interface{}) error {
+	raw := make([]byte, base64.StdEncoding.DecodedLen(len(data)))
+	n, err := base64.StdEncoding.Decode(raw, data)
+	if err != nil {
+		return err
+	}
+	return gob.NewDecoder(bytes.NewReader(raw[:n])).Decode(v)
+}
+
+// FormatSerializedValue serialize the value and format it as a nebula graph string, nil pointer is formatted as NULL
+func FormatSerializedValue(serializer Serializer, value reflect.Value) (string, error) {
+	if !value.IsValid() {
+		return "", fmt.Errorf("nebulaorm: format serialized value failed, invalid value")
+	}
+	if (value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface) && value.IsNil() {
+		return "NULL", nil
+	}
+	data, err := serializer.Marshal(value.Interface())
+	if err != nil {
+		return "", fmt.Errorf("nebulaorm: format serialized value failed, %w", err)
+	}
+	return strconv.Quote(string(data)), nil
+}
+
+// ScanSerializedValue deserialize the string returned by nebula graph into dest value
+func ScanSerializedValue(serializer Serializer, nebulaValue *nebula.ValueWrapper, destValue reflect.Value) error {
+	if !destValue.CanSet() || !destValue.CanAddr() {
+		return fmt.Errorf("nebulaorm: scan serialized value failed, %w", ErrValueCannotSet)
+	}
+	switch nebulaValue.GetType() {
+	case NebulaDataTypeNull:
+		destValue.Set(reflect.Zero(destValue.Type()))
+		return nil
+	case NebulaDataTypeString:
+		data, _ := nebulaValue.AsString()
+		if data == "" {
+			destValue.Set(reflect.Zero(destValue.Type()))
+			return nil
+		}
+		if err := serializer.Unmarshal([]byte(data), destValue.Addr().Interface()); err != nil {
+			return fmt.Errorf("nebulaorm: scan serialized value failed, %w", err)
+		}
+		return nil
+	}
+	return fmt.Errorf("nebulaorm: scan serialized value failed, nebula type %s is not string", nebulaValue.GetType())
+}
@@ -0,0 +1,181 @@
+package resolver
+
+import (
+	"fmt"
+	nebula "github.com/vesoft-inc/nebula-go/v3"
+	nebulatype "github.com/vesoft-inc/nebula-go/v3/nebula"
+	"github.com/vesoft-inc/nebula-go/v3/nebula/graph"
+	"reflect"
+	"testing"
+)
+
+type serializerConf struct {
+	Host string `json:"host"`
+	Port int    `json:"port"`
+}
+
+// serializerRaw write the value as it is formatted and read the string back
+type serializerRaw struct{}
+
+func (serializerRaw) Marshal(v interface{}) ([]byte, error) {
+	return []byte(fmt.Sprintf("%v", v)), nil
+}
+
+func (serializerRaw) Unmarshal(data []byte, v interface{}) error {
+	reflect.ValueOf(v).Elem().SetString(string(data))
+	return nil
+}
+
+func TestFormatSerializedValue(t *testing.T) {
+	RegisterSerializer("Raw", serializerRaw{})
+	conf := &serializerConf{Host: "127.0.0.1", Port: 9669}
+	tests := []struct {
+		serializer string
+		value      interface{}
+		want       string
+		wantErr    bool
+	}{
+		{serializer: SerializerJSON, value: conf, want: `"{\"host\":\"127.0.0.1\",\"port\":9669}"`},
+		{serializer: SerializerJSON, value: map[string]int{"a": 1}, want: `"{\"a\":1}"`},
+		{serializer: SerializerJSON, value: []string{"a", "b"}, want: `"[\"a\",\"b\"]"`},
+		{serializer: SerializerJSON, value: (*serializerConf)(nil), want: `NULL`},
+		{serializer: "raw", value: "hello", want: `"hello"`},
+		{serializer: SerializerJSON, value: make(chan int), wantErr: true},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			serializer, ok := GetSerializer(tt.serializer)
+			if !ok {
+				t.Errorf("GetSerializer() serializer %s not found", tt.serializer)
+				return
+			}
+			got, err := FormatSerializedValue(serializer, reflect.ValueOf(tt.value))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FormatSerializedValue() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("FormatSerializedValue() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScanSerializedValue(t *testing.T) {
+	type propStruct struct {
+		Conf    serializerConf  `norm:"prop:conf;serializer:json"`
+		ConfPtr *serializerConf `norm:"prop:conf_ptr;serializer:json"`
+		Labels  map[string]int  `norm:"prop:labels;serializer:json"`
+		Tags    []string        `norm:"prop:tags;serializer:json"`
+		Empty   []string        `norm:"prop:empty;serializer:json"`
+		Null    *serializerConf `norm:"prop:null;serializer:json"`
+		Invalid serializerConf  `norm:"prop:invalid;serializer:json"`
+		Age     serializerConf  `norm:"prop:age;serializer:json"`
+	}
+	null := nebulatype.NullType___NULL__
+	resp := &graph.ExecutionResponse{
+		Data: &nebulatype.DataSet{
+			ColumnNames: [][]byte{[]byte("conf"), []byte("conf_ptr"), []byte("labels"), []byte("tags"), []byte("empty"), []byte("null"), []byte("invalid"), []byte("age")},
+			Rows: []*nebulatype.Row{{Values: []*nebulatype.Value{
+				{SVal: []byte(`{"host":"127.0.0.1","port":9669}`)},
+				{SVal: []byte(`{"host":"127.0.0.2","port":9779}`)},
+				{SVal: []byte(`{"a":1,"b":2}`)},
+				{SVal: []byte(`["mvp","fmvp"]`)},
+				{SVal: []byte(``)},
+				{NVal: &null},
+				{SVal: []byte(`{"host":`)},
+				{IVal: new(int64)},
+			}}},
+		},
+	}
+	resultSet, err := nebula.GenResultSet(resp)
+	if err != nil {
+		t.Fatalf("GenResultSet() error = %v", err)
+	}
+	record, _ := resultSet.GetRowValuesByIndex(0)
+
+	got := propStruct{Empty: []string{"stale"}, Null: &serializerConf{}}
+	gotValue := reflect.ValueOf(&got).Elem()
+	rv := NewResolver()
+	for i := 0; i < gotValue.NumField(); i++ {
+		prop, err := ParseProp(gotValue.Type().Field(i))
+		if err != nil {
+			t.Fatalf("ParseProp() error = %v", err)
+		}
+		nebulaValue, _ := record.GetValueByColName(prop.Name)
+		err = prop.ScanValue(rv, nebulaValue, gotValue.Field(i))
+		if wantErr := prop.Name == "invalid" || prop.Name == "age"; (err != nil) != wantErr {
+			t.Errorf("ScanValue() prop %s error = %v, wantErr %v", prop.Name, err, wantErr)
+		}
+	}
+	want := propStruct{
+		Conf:    serializerConf{Host: "127.0.0.1", Port: 9669},
+		ConfPtr: &serializerConf{Host: "127.0.0.2", Port: 9779},
+		Labels:  map[string]int{"a": 1, "b": 2},
+		Tags:    []string{"mvp", "fmvp"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ScanValue() got = %+v, want %+v", got, want)
+	}
+	// the value can not be set
+	nebulaValue, _ := record.GetValueByColName("conf")
+	if err = ScanSerializedValue(JSONSerializer{}, nebulaValue, reflect.ValueOf(serializerConf{})); err == nil {
+		t.Errorf("ScanSerializedValue() should return an error for the value that can not be set")
+	}
+}
+
+func TestSerializerRoundTrip(t *testing.T) {
+	conf := serializerConf{Host: "127.0.0.1", Port: 9669}
+	for _, name := range []string{SerializerJSON, SerializerGob} {
+		t.Run(name, func(t *testing.T) {
+			serializer, _ := GetSerializer(name)
+			data, err := serializer.Marshal(conf)
+			if err != nil {
+				t.Errorf("Marshal() error = %v", err)
+				return
+			}
+			got := serializerConf{}
+			if err = serializer.Unmarshal(data, &got); err != nil {
+				t.Errorf("Unmarshal() error = %v", err)
+				return
+			}
+			if got != conf {
+				t.Errorf("Unmarshal() got = %+v, want %+v", got, conf)
+			}
+		})
+	}
+}
+
+func TestParseProp(t *testing.T) {
+	type propStruct struct {
+		Conf    serializerConf `norm:"prop:conf;serializer:json"`
+		Name    string         `norm:"prop:name"`
+		Unknown []int          `norm:"serializer:unknown"`
+	}
+	propType := reflect.TypeOf(propStruct{})
+	tests := []struct {
+		field          int
+		wantName       string
+		wantSerializer Serializer
+		wantErr        bool
+	}{
+		{field: 0, wantName: "conf", wantSerializer: JSONSerializer{}},
+		{field: 1, wantName: "name"},
+		{field: 2, wantErr: true},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			got, err := ParseProp(propType.Field(tt.field))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseProp() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if got.Name != tt.wantName || got.Serializer != tt.wantSerializer {
+				t.Errorf("ParseProp() got = %+v, want name %v serializer %v", got, tt.wantName, tt.wantSerializer)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package resolver
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 	"time"
@@ -8,15 +9,22 @@ import (
 )
 
 const (
-	TagSettingKey       = "norm"        // nebulaorm struct tag key
-	TagSettingColName   = "col"         // name of the field in the record
-	TagSettingVertexID  = "vertex_id"   // annotate that the field is a vertex id
-	TagSettingEdgeSrcID = "edge_src_id" // annotate that the field is an edge source id
-	TagSettingEdgeDstID = "edge_dst_id" // annotate that the field is an edge dest id
-	TagSettingEdgeRank  = "edge_rank"   // annotate that the field is an edge rank
-	TagSettingPropName  = "prop"        // property name, vertex or edge
-	TagSettingDataType  = "datatype"    // specify the data type (in this case the data type specified in github.com/vesoft-inc/nebula-go/v3)
-	TagSettingIgnore    = "-"           // nebulaorm will ignore this field
+	TagSettingKey        = "norm"           // nebulaorm struct tag key
+	TagSettingColName    = "col"            // name of the field in the record
+	TagSettingVertexID   = "vertex_id"      // annotate that the field is a vertex id
+	TagSettingEdgeSrcID  = "edge_src_id"    // annotate that the field is an edge source id
+	TagSettingEdgeDstID  = "edge_dst_id"    // annotate that the field is an edge dest id
+	TagSettingEdgeRank   = "edge_rank"      // annotate that the field is an edge rank
+	TagSettingPropName   = "prop"           // property name, vertex or edge
+	TagSettingDataType   = "datatype"       // specify the data type (in this case the data type specified in github.com/vesoft-inc/nebula-go/v3)
+	TagSettingSerializer = "serializer"     // name of the serializer used to save the field as a string property, eg: json
+	TagSettingDefault    = "default"        // default value of the property, used when inserting a zero value
+	TagSettingCreateTime = "autocreatetime" // fill the property with the current time when inserting a zero value
+	TagSettingUpdateTime = "autoupdatetime" // fill the property with the current time when inserting or updating
+	TagSettingNotNull    = "not null"       // the property can not be NULL, it is checked before writing
+	TagSettingSchemaType = "type"           // data type of the property in the schema, eg: fixed_string(32), int8, it is checked before writing
+	TagSettingYield      = "yield"          // the expression yielded as the column of the record, eg: properties($$).name
+	TagSettingIgnore     = "-"              // nebulaorm will ignore this field
 )
 
 func ParseTagSetting(s string) map[string]string {
@@ -51,6 +59,20 @@ func GetValueNebulaType(field reflect.StructField) string {
 	return setting[TagSettingDataType]
 }
 
+// GetValueSerializer get the serializer specified by the field, return nil if the field does not specify a serializer
+func GetValueSerializer(field reflect.StructField) (Serializer, error) {
+	setting := ParseTagSetting(field.Tag.Get(TagSettingKey))
+	name := setting[TagSettingSerializer]
+	if name == "" {
+		return nil, nil
+	}
+	serializer, ok := GetSerializer(name)
+	if !ok {
+		return nil, fmt.Errorf("nebulaorm: serializer %s of field %s is not registered", name, field.Name)
+	}
+	return serializer, nil
+}
+
 func FieldIgnore(field reflect.StructField) bool {
 	setting := ParseTagSetting(field.Tag.Get(TagSettingKey))
 	return setting[TagSettingIgnore] != ""
@@ -71,10 +93,15 @@ func camelCaseToUnderscore(s string) string {
 	return string(output)
 }
 
-var timezoneDefault = time.Local
-
+// SetTimezone set the timezone of the default resolver, which is used by the package level functions.
+// Deprecated: the timezone is shared by the whole process, use NewResolver with WithTimezone instead.
 func SetTimezone(loc *time.Location) {
 	if loc != nil {
-		timezoneDefault = loc
+		defaultResolver.timezone = loc
 	}
 }
+
+// Now get the current time in the timezone of the default resolver
+func Now() time.Time {
+	return defaultResolver.Now()
+}
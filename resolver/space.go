@@ -0,0 +1,24 @@
+package resolver
+
+import "reflect"
+
+// SpaceNamer specifies the graph space that the vertex or edge belongs to, the statements built with the structure
+// that implements this interface are executed in that space instead of the space of the connection.
+type SpaceNamer interface {
+	SpaceName() string
+}
+
+// GetSpaceName get the space name declared by the type through the SpaceNamer interface, pointers, slices and arrays
+// are dereferenced to the element type, an empty string is returned if the type does not declare a space
+func GetSpaceName(destType reflect.Type) string {
+	for destType.Kind() == reflect.Ptr || destType.Kind() == reflect.Slice || destType.Kind() == reflect.Array {
+		destType = destType.Elem()
+	}
+	if destType.Kind() != reflect.Struct {
+		return ""
+	}
+	if spaceNamer, ok := reflect.New(destType).Interface().(SpaceNamer); ok {
+		return spaceNamer.SpaceName()
+	}
+	return ""
+}
@@ -0,0 +1,34 @@
+package resolver
+
+import (
+	"reflect"
+	"testing"
+)
+
+type spaceVertex struct {
+	VID string `norm:"vertex_id"`
+}
+
+func (v spaceVertex) SpaceName() string {
+	return "basketball"
+}
+
+func TestGetSpaceName(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		want  string
+	}{
+		{value: spaceVertex{}, want: "basketball"},
+		{value: &spaceVertex{}, want: "basketball"},
+		{value: &[]*spaceVertex{}, want: "basketball"},
+		{value: [2]spaceVertex{}, want: "basketball"},
+		{value: vertex1{}, want: ""},
+		{value: "player100", want: ""},
+		{value: map[string]interface{}{}, want: ""},
+	}
+	for _, tt := range tests {
+		if got := GetSpaceName(reflect.TypeOf(tt.value)); got != tt.want {
+			t.Errorf("GetSpaceName(%T) got = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
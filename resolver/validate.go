@@ -0,0 +1,181 @@
+package resolver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	ValidateRuleNotNull     = "not null"
+	ValidateRuleFixedString = "fixed_string"
+	ValidateRuleRange       = "range"
+	ValidateRuleVID         = "vid"
+)
+
+var (
+	// ErrValidationFailed the data to be written does not satisfy the constraints declared in the struct tag
+	ErrValidationFailed = errors.New("validation failed")
+
+	fixedStringRegexp = regexp.MustCompile(`^fixed_string\((\d+)\)$`)
+)
+
+// FieldError the validation error of a single field
+type FieldError struct {
+	Field   string // name of the struct field
+	Prop    string // name of the property, empty for vertex id and edge id
+	Rule    string // the rule that is not satisfied, eg: not null
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	if e.Prop != "" {
+		return fmt.Sprintf("field %s(prop %s): %s", e.Field, e.Prop, e.Message)
+	}
+	return fmt.Sprintf("field %s: %s", e.Field, e.Message)
+}
+
+// ValidationError contains the validation errors of all the fields that failed, it can be matched with
+// errors.Is(err, ErrValidationFailed) or obtained through errors.As
+type ValidationError struct {
+	Errors []*FieldError
+}
+
+// Add the validation error of a field, nil is ignored
+func (e *ValidationError) Add(fieldErr *FieldError) {
+	if fieldErr != nil {
+		e.Errors = append(e.Errors, fieldErr)
+	}
+}
+
+// Err return nil if there is no validation error, so that it can be returned directly
+func (e *ValidationError) Err() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+	return e
+}
+
+func (e *ValidationError) Error() string {
+	msg := make([]string, 0, len(e.Errors))
+	for _, fieldErr := range e.Errors {
+		msg = append(msg, fieldErr.Error())
+	}
+	return "nebulaorm: " + ErrValidationFailed.Error() + ", " + strings.Join(msg, "; ")
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrValidationFailed
+}
+
+// Validate check whether the value of the property satisfies the constraints declared in the tag, such as
+// `norm:"not null;type:fixed_string(32)"`, return nil if the value is valid
+func (p *Prop) Validate(value reflect.Value) *FieldError {
+	if p.NotNull && isNullValue(value) {
+		return p.fieldError(ValidateRuleNotNull, "value can not be null")
+	}
+	if p.SchemaType == "" {
+		return nil
+	}
+	value = simpleValue(value)
+	if !value.IsValid() {
+		return nil
+	}
+	if matches := fixedStringRegexp.FindStringSubmatch(p.SchemaType); len(matches) == 2 && value.Kind() == reflect.String {
+		maxLen, _ := strconv.Atoi(matches[1])
+		if len(value.String()) > maxLen {
+			return p.fieldError(ValidateRuleFixedString, fmt.Sprintf("length %d exceeds %s", len(value.String()), p.SchemaType))
+		}
+		return nil
+	}
+	var bits int
+	switch p.SchemaType {
+	case "int8":
+		bits = 8
+	case "int16":
+		bits = 16
+	case "int32":
+		bits = 32
+	default:
+		return nil
+	}
+	minValue, maxValue := int64(-1)<<(bits-1), int64(1)<<(bits-1)-1
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v := value.Int(); v < minValue || v > maxValue {
+			return p.fieldError(ValidateRuleRange, fmt.Sprintf("value %d out of %s range [%d, %d]", v, p.SchemaType, minValue, maxValue))
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v := value.Uint(); v > uint64(maxValue) {
+			return p.fieldError(ValidateRuleRange, fmt.Sprintf("value %d out of %s range [%d, %d]", v, p.SchemaType, minValue, maxValue))
+		}
+	case reflect.Float32, reflect.Float64:
+		if v := value.Float(); v < float64(minValue) || v > float64(maxValue) || math.IsNaN(v) {
+			return p.fieldError(ValidateRuleRange, fmt.Sprintf("value %v out of %s range [%d, %d]", v, p.SchemaType, minValue, maxValue))
+		}
+	}
+	return nil
+}
+
+func (p *Prop) fieldError(rule, msg string) *FieldError {
+	return &FieldError{Field: p.StructField.Name, Prop: p.Name, Rule: rule, Message: msg}
+}
+
+// ValidateVID check whether the vertex id is empty
+func (v *VertexSchema) ValidateVID(vertexValue reflect.Value) *FieldError {
+	if v.GetVIDExpr(vertexValue) == "" {
+		return &FieldError{Field: v.vidFieldName(vertexValue), Rule: ValidateRuleVID, Message: "vertex id is empty"}
+	}
+	return nil
+}
+
+func (v *VertexSchema) vidFieldName(vertexValue reflect.Value) string {
+	if v.vidFieldIndex >= 0 {
+		return reflect.Indirect(vertexValue).Type().Field(v.vidFieldIndex).Name
+	}
+	return "VertexID()"
+}
+
+// ValidateVID check whether the src_id and dst_id of the edge are empty
+func (e *EdgeSchema) ValidateVID(edgeValue reflect.Value) []*FieldError {
+	var fieldErrs []*FieldError
+	edgeType := reflect.Indirect(edgeValue).Type()
+	if e.GetSrcVIDExpr(edgeValue) == "" {
+		fieldErrs = append(fieldErrs, &FieldError{Field: edgeType.Field(e.srcVIDFieldIndex).Name, Rule: ValidateRuleVID, Message: "edge src_id is empty"})
+	}
+	if e.GetDstVIDExpr(edgeValue) == "" {
+		fieldErrs = append(fieldErrs, &FieldError{Field: edgeType.Field(e.dstVIDFieldIndex).Name, Rule: ValidateRuleVID, Message: "edge dst_id is empty"})
+	}
+	return fieldErrs
+}
+
+// isNullValue whether the value is formatted as NULL
+func isNullValue(value reflect.Value) bool {
+	return !simpleValue(value).IsValid()
+}
+
+// simpleValue get the underlying value of pointers and Valuer, an invalid value is returned if it is NULL
+func simpleValue(value reflect.Value) reflect.Value {
+	for value.IsValid() {
+		switch value.Kind() {
+		case reflect.Ptr, reflect.Interface:
+			if value.IsNil() {
+				return reflect.Value{}
+			}
+			value = value.Elem()
+			continue
+		}
+		if value.CanInterface() {
+			if valuer, ok := value.Interface().(Valuer); ok {
+				value = reflect.ValueOf(valuer.NebulaValue())
+				continue
+			}
+		}
+		break
+	}
+	return value
+}
@@ -0,0 +1,93 @@
+package resolver
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestPropValidate(t *testing.T) {
+	type propStruct struct {
+		Name  *string    `norm:"prop:name;not null"`
+		Code  string     `norm:"prop:code;type:FIXED_STRING(4)"`
+		Age   int        `norm:"prop:age;type:int8"`
+		Score uint32     `norm:"prop:score;type:int16"`
+		Level NullInt64  `norm:"prop:level;not null;type:int32"`
+		Desc  NullString `norm:"prop:desc;type:fixed_string(2)"`
+	}
+	name := "n1"
+	propType := reflect.TypeOf(propStruct{})
+	tests := []struct {
+		field    int
+		value    interface{}
+		wantRule string
+	}{
+		{field: 0, value: &name},
+		{field: 0, value: (*string)(nil), wantRule: ValidateRuleNotNull},
+		{field: 1, value: "abcd"},
+		{field: 1, value: "abcde", wantRule: ValidateRuleFixedString},
+		{field: 2, value: 127},
+		{field: 2, value: -129, wantRule: ValidateRuleRange},
+		{field: 3, value: uint32(32768), wantRule: ValidateRuleRange},
+		{field: 4, value: NullInt64{}, wantRule: ValidateRuleNotNull},
+		{field: 4, value: NullInt64{Int64: 1 << 31, Valid: true}, wantRule: ValidateRuleRange},
+		{field: 5, value: NullString{}},
+		{field: 5, value: NullString{String: "abc", Valid: true}, wantRule: ValidateRuleFixedString},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case #%d", i), func(t *testing.T) {
+			prop, err := ParseProp(propType.Field(tt.field))
+			if err != nil {
+				t.Errorf("ParseProp() error = %v", err)
+				return
+			}
+			got := prop.Validate(reflect.ValueOf(tt.value))
+			if tt.wantRule == "" {
+				if got != nil {
+					t.Errorf("Validate() got unexpected error %v", got)
+				}
+				return
+			}
+			if got == nil || got.Rule != tt.wantRule {
+				t.Errorf("Validate() got = %v, want rule %v", got, tt.wantRule)
+			}
+		})
+	}
+}
+
+func TestValidationError(t *testing.T) {
+	validationErr := new(ValidationError)
+	validationErr.Add(nil)
+	if validationErr.Err() != nil {
+		t.Errorf("Err() should be nil if there is no field error")
+	}
+	validationErr.Add(&FieldError{Field: "Name", Prop: "name", Rule: ValidateRuleNotNull, Message: "value can not be null"})
+	validationErr.Add(&FieldError{Field: "VID", Rule: ValidateRuleVID, Message: "vertex id is empty"})
+	err := fmt.Errorf("wrap: %w", validationErr.Err())
+	if !errors.Is(err, ErrValidationFailed) {
+		t.Errorf("errors.Is(err, ErrValidationFailed) should be true")
+	}
+	var target *ValidationError
+	if !errors.As(err, &target) || len(target.Errors) != 2 {
+		t.Errorf("errors.As(err, *ValidationError) failed")
+	}
+	want := "nebulaorm: validation failed, field Name(prop name): value can not be null; field VID: vertex id is empty"
+	if validationErr.Error() != want {
+		t.Errorf("Error() got = %v, want %v", validationErr.Error(), want)
+	}
+}
+
+func TestValidateVID(t *testing.T) {
+	vertexSchema, _ := ParseVertex(reflect.TypeOf(vertex1{}))
+	if fieldErr := vertexSchema.ValidateVID(reflect.ValueOf(vertex1{})); fieldErr == nil || fieldErr.Field != "VID" {
+		t.Errorf("ValidateVID() got = %v, want error of field VID", fieldErr)
+	}
+	if fieldErr := vertexSchema.ValidateVID(reflect.ValueOf(&vertex1{VID: "v1"})); fieldErr != nil {
+		t.Errorf("ValidateVID() got unexpected error %v", fieldErr)
+	}
+	edgeSchema, _ := ParseEdge(reflect.TypeOf(edge1{}))
+	if fieldErrs := edgeSchema.ValidateVID(reflect.ValueOf(edge1{})); len(fieldErrs) != 2 {
+		t.Errorf("ValidateVID() got = %v, want 2 errors", fieldErrs)
+	}
+}
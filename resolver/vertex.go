@@ -41,7 +41,7 @@ type VertexSchema struct {
 	vidReceiverIsPtr bool
 }
 
-func ParseVertex(destType reflect.Type) (*VertexSchema, error) {
+func parseVertex(destType reflect.Type) (*VertexSchema, error) {
 	if destType.Kind() == reflect.Ptr {
 		destType = destType.Elem()
 	}
@@ -143,23 +143,19 @@ func (v *VertexSchema) parseTag(destType reflect.Type, superIndex int) error {
 		if _, ok := setting[TagSettingVertexID]; ok {
 			continue
 		}
-		propName := GetPropName(structField)
-		nebulaType := GetValueNebulaType(structField)
 		// tag may exist in a multi-level structure, the index value of the field needs to be added to the index value of the parent field
 		if superIndex >= 0 {
 			structField.Index = append([]int{superIndex}, structField.Index...)
 		}
-		prop := &Prop{
-			Name:        propName,
-			StructField: structField,
-			Type:        structField.Type,
-			NebulaType:  nebulaType,
+		prop, err := ParseProp(structField)
+		if err != nil {
+			return err
 		}
-		if _, ok := v.tagByName[tagName].propByName[propName]; ok {
+		if _, ok := v.tagByName[tagName].propByName[prop.Name]; ok {
 			continue
 		}
 		v.tagByName[tagName].props = append(v.tagByName[tagName].props, prop)
-		v.tagByName[tagName].propByName[propName] = prop
+		v.tagByName[tagName].propByName[prop.Name] = prop
 	}
 	return nil
 }
@@ -188,6 +184,9 @@ func (v *VertexSchema) GetVIDExpr(vertexValue reflect.Value) string {
 	}
 	switch v.vidType {
 	case VIDTypeString:
+		if vid.(string) == "" {
+			return ""
+		}
 		return strconv.Quote(vid.(string))
 	case VIDTypeInt64:
 		return fmt.Sprintf("%d", vid)
@@ -201,7 +200,7 @@ func (v *VertexSchema) GetTags() []*VertexTag {
 }
 
 // Scan assigns the nodes returned by the nebula graph to the vertex data in the business layer
-func (v *VertexSchema) Scan(node *nebula.Node, destValue reflect.Value) error {
+func (v *VertexSchema) Scan(r *Resolver, node *nebula.Node, destValue reflect.Value) error {
 	// schema parsing and assignment can support structs or struct pointers
 	destValue = reflect.Indirect(destValue)
 	if !destValue.CanSet() {
@@ -210,7 +209,7 @@ func (v *VertexSchema) Scan(node *nebula.Node, destValue reflect.Value) error {
 	// if a vid field exists in the structure, it is assigned to it
 	if v.vidFieldIndex >= 0 {
 		vid := node.GetID()
-		if err := ScanSimpleValue(&vid, destValue.Field(v.vidFieldIndex)); err != nil {
+		if err := r.ScanSimpleValue(&vid, destValue.Field(v.vidFieldIndex)); err != nil {
 			return err
 		}
 	}
@@ -224,7 +223,7 @@ func (v *VertexSchema) Scan(node *nebula.Node, destValue reflect.Value) error {
 			if !ok {
 				continue
 			}
-			if err = ScanSimpleValue(propValue, destValue.FieldByIndex(prop.StructField.Index)); err != nil {
+			if err = prop.ScanValue(r, propValue, destValue.FieldByIndex(prop.StructField.Index)); err != nil {
 				return err
 			}
 		}
@@ -238,13 +237,6 @@ type VertexTag struct {
 	propByName map[string]*Prop // key: prop name
 }
 
-type Prop struct {
-	Name        string
-	StructField reflect.StructField
-	Type        reflect.Type
-	NebulaType  string
-}
-
 // GetProps get all attributes of the tag
 func (t *VertexTag) GetProps() []*Prop {
 	return t.props
@@ -17,20 +17,12 @@ func (db *DB) NGQL() (string, error) {
 
 // RawResult exec the statement and return the result of nebula-go directly
 func (db *DB) RawResult() (*nebula.ResultSet, error) {
-	nGQL, err := db.NGQL()
-	if err != nil {
-		return nil, err
-	}
-	return db.sessionPool.Execute(nGQL)
+	return db.getInstance().execute()
 }
 
 // Exec the statement, but don't care about the result as long as it is used for insert, update, delete operations
 func (db *DB) Exec() error {
-	nGQL, err := db.NGQL()
-	if err != nil {
-		return err
-	}
-	res, err := db.sessionPool.Execute(nGQL)
+	res, err := db.getInstance().execute()
 	if err != nil {
 		return err
 	}
@@ -42,66 +34,131 @@ func (db *DB) Exec() error {
 
 // Find exec the statement and assign the returned result to the dest variable
 func (db *DB) Find(dest interface{}) error {
-	rawRes, err := db.RawResult()
+	tx := db.getInstance()
+	tx.useModelSpace(dest)
+	rawRes, err := tx.execute()
 	if err != nil {
 		return err
 	}
-	return Scan(rawRes, dest)
+	if err = scan(rawRes, dest, false, tx.resolver); err != nil {
+		return err
+	}
+	return tx.callAfterFind(dest)
 }
 
 // FindCol parse one column of the result, it is used to easily get the value of a field
 func (db *DB) FindCol(col string, dest interface{}) error {
-	rawRes, err := db.RawResult()
+	tx := db.getInstance()
+	tx.useModelSpace(dest)
+	rawRes, err := tx.execute()
 	if err != nil {
 		return err
 	}
-	return Pluck(rawRes, col, dest)
+	if err = pluck(rawRes, col, dest, false, tx.resolver); err != nil {
+		return err
+	}
+	return tx.callAfterFind(dest)
 }
 
 // Take get a single test result, if no limit is specified, limit 1 will be added automatically,
 // if the final return value is empty, will return  ErrRecordNotFound
 func (db *DB) Take(dest interface{}) error {
 	tx := db.getInstance()
+	tx.useModelSpace(dest)
 	lastPart := tx.Statement.LastPart()
 	if lastPart.GetType() != statement.PartTypeLimit {
 		tx.Statement.Limit(1)
 	}
-	nGQL, err := tx.Statement.NGQL()
+	rawRes, err := tx.execute()
 	if err != nil {
 		return err
 	}
-	rawRes, err := db.sessionPool.Execute(nGQL)
-	if err != nil {
+	if err = scan(rawRes, dest, !tx.dryRun, tx.resolver); err != nil {
 		return err
 	}
-	return scan(rawRes, dest, true)
+	return tx.callAfterFind(dest)
 }
 
 // TakeCol parse one column of the result, it is used to easily get the value of a field
 // if the final return value is empty, will return  ErrRecordNotFound
 func (db *DB) TakeCol(col string, dest interface{}) error {
 	tx := db.getInstance()
+	tx.useModelSpace(dest)
 	lastPart := tx.Statement.LastPart()
 	if lastPart.GetType() != statement.PartTypeLimit {
 		tx.Statement.Limit(1)
 	}
-	nGQL, err := tx.Statement.NGQL()
+	rawRes, err := tx.execute()
 	if err != nil {
 		return err
 	}
-	rawRes, err := db.sessionPool.Execute(nGQL)
+	if err = pluck(rawRes, col, dest, !tx.dryRun, tx.resolver); err != nil {
+		return err
+	}
+	return tx.callAfterFind(dest)
+}
+
+// Returning exec the update statement with the yield clause generated from the dest, and assign the updated record to
+// the dest, exprs are the additional expressions to be yielded, ErrRecordNotFound is returned if nothing is yielded.
+// see more information on the method of the same name in statement.Statement
+//
+// UPDATE VERTEX ON player "player101" SET age = age + 2 YIELD name AS name, age AS age
+// db.UpdateVertex("player101", map[string]interface{}{"age": clause.Expr{Str: "age + 2"}}, clause.WithTagName("player")).Returning(&player)
+func (db *DB) Returning(dest interface{}, exprs ...string) error {
+	tx := db.getInstance()
+	tx.Statement.Returning(dest, exprs...)
+	rawRes, err := tx.execute()
 	if err != nil {
 		return err
 	}
-	return pluck(rawRes, col, dest, true)
+	if err = scan(rawRes, dest, !tx.dryRun, tx.resolver); err != nil {
+		return err
+	}
+	return tx.callAfterFind(dest)
+}
+
+// execute build and exec the statement, the hooks of the models are called around the execution
+func (db *DB) execute() (*nebula.ResultSet, error) {
+	if err := db.callBeforeHooks(); err != nil {
+		return nil, err
+	}
+	nGQL, err := db.Statement.NGQL()
+	if err != nil {
+		return nil, err
+	}
+	connSpace := db.conf.SpaceName
+	var c *cluster
+	if db.router != nil {
+		if c, err = db.router.route(db.cluster, db.Statement.ReadOnly()); err != nil {
+			return nil, err
+		}
+		connSpace = c.spaceName
+	}
+	if nGQL, err = db.withSpace(nGQL, connSpace); err != nil {
+		return nil, err
+	}
+	if db.dryRun {
+		return db.dryRunResult(nGQL)
+	}
+	res, err := c.executor.Execute(nGQL)
+	if err != nil {
+		return nil, err
+	}
+	if res.IsSucceed() {
+		if err = db.callAfterHooks(); err != nil {
+			return res, err
+		}
+	}
+	return res, nil
 }
 
-// Scan assign the results to the target variable
+// Scan assign the results to the target variable, date and datetime are converted with the timezone of the default
+// resolver, use db.Find to convert them with the timezone of the DB
 func Scan(rawRes *nebula.ResultSet, dest interface{}) error {
-	return scan(rawRes, dest, false)
+	return scan(rawRes, dest, false, nil)
 }
 
-func scan(rawRes *nebula.ResultSet, dest interface{}, raiseNotFound bool) error {
+func scan(rawRes *nebula.ResultSet, dest interface{}, raiseNotFound bool, rv *resolver.Resolver) error {
 	if !rawRes.IsSucceed() {
 		return fmt.Errorf("nebulaorm: result is not succeed, err code: %d, msg: %s", rawRes.GetErrorCode(), rawRes.GetErrorMsg())
 	}
@@ -121,13 +178,13 @@ func scan(rawRes *nebula.ResultSet, dest interface{}, raiseNotFound bool) error
 		if err != nil {
 			return err
 		}
-		return scanIntoMap(record, rawRes.GetColNames(), *v)
+		return scanIntoMap(rv, record, rawRes.GetColNames(), *v)
 	case map[string]interface{}:
 		record, err := rawRes.GetRowValuesByIndex(0)
 		if err != nil {
 			return err
 		}
-		return scanIntoMap(record, rawRes.GetColNames(), v)
+		return scanIntoMap(rv, record, rawRes.GetColNames(), v)
 	case *[]map[string]interface{}:
 		for i := 0; i < rawRes.GetRowSize(); i++ {
 			record, err := rawRes.GetRowValuesByIndex(i)
@@ -135,7 +192,7 @@ func scan(rawRes *nebula.ResultSet, dest interface{}, raiseNotFound bool) error
 				return err
 			}
 			value := make(map[string]interface{}, len(rawRes.GetColNames()))
-			if err = scanIntoMap(record, rawRes.GetColNames(), value); err != nil {
+			if err = scanIntoMap(rv, record, rawRes.GetColNames(), value); err != nil {
 				return err
 			}
 			*v = append(*v, value)
@@ -150,7 +207,6 @@ func scan(rawRes *nebula.ResultSet, dest interface{}, raiseNotFound bool) error
 		if !destValue.IsValid() {
 			return fmt.Errorf("nebulaorm: %w, scan dest should be pointer to struct, slice or array", ErrInvalidValue)
 		}
-		rv := resolver.NewResolver()
 		switch destValue.Kind() {
 		case reflect.Slice, reflect.Array:
 			return utils.SliceSetElem(destValue, rawRes.GetRowSize(), func(i int, elem reflect.Value) (bool, error) {
@@ -177,13 +233,13 @@ func scan(rawRes *nebula.ResultSet, dest interface{}, raiseNotFound bool) error
 }
 
 // scanIntoMap scan a row into map
-func scanIntoMap(record *nebula.Record, colNames []string, dest map[string]interface{}) error {
+func scanIntoMap(rv *resolver.Resolver, record *nebula.Record, colNames []string, dest map[string]interface{}) error {
 	for _, colName := range colNames {
 		colValue, err := record.GetValueByColName(colName)
 		if err != nil {
 			return err
 		}
-		dest[colName], err = resolver.GetValueIface(colValue)
+		dest[colName], err = rv.GetValueIface(colValue)
 		if err != nil {
 			return err
 		}
@@ -191,12 +247,13 @@ func scanIntoMap(record *nebula.Record, colNames []string, dest map[string]inter
 	return nil
 }
 
-// Pluck assign one of the fields of the return value into dest
+// Pluck assign one of the fields of the return value into dest, date and datetime are converted with the timezone of
+// the default resolver, use db.FindCol to convert them with the timezone of the DB
 func Pluck(rawRes *nebula.ResultSet, col string, dest interface{}) error {
-	return pluck(rawRes, col, dest, false)
+	return pluck(rawRes, col, dest, false, nil)
 }
 
-func pluck(rawRes *nebula.ResultSet, col string, dest interface{}, raiseNotFound bool) error {
+func pluck(rawRes *nebula.ResultSet, col string, dest interface{}, raiseNotFound bool, rv *resolver.Resolver) error {
 	if !rawRes.IsSucceed() {
 		return fmt.Errorf("nebulaorm: result is not succeed, err code: %d, msg: %s", rawRes.GetErrorCode(), rawRes.GetErrorMsg())
 	}
@@ -219,7 +276,6 @@ func pluck(rawRes *nebula.ResultSet, col string, dest interface{}, raiseNotFound
 	if !destValue.IsValid() {
 		return fmt.Errorf("nebulaorm: %w, dest must be able to assign, such as pointers for each type or map", ErrInvalidValue)
 	}
-	rv := resolver.NewResolver()
 	switch destValue.Kind() {
 	case reflect.Slice, reflect.Array:
 		return utils.SliceSetElem(destValue, rawRes.GetRowSize(), func(i int, elem reflect.Value) (bool, error) {
@@ -0,0 +1,131 @@
+package nebulaorm
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// ClusterPrimary name of the primary cluster, which is configured by Config.Addresses
+const ClusterPrimary = "primary"
+
+type cluster struct {
+	name      string
+	spaceName string
+	executor  Executor
+}
+
+// router holds the executors of all the clusters and decides which cluster the statement is executed on
+type router struct {
+	primary    *cluster
+	clusters   map[string]*cluster
+	replicas   []*cluster
+	readPolicy string
+	next       uint64
+}
+
+// executorFactory create the executor of the cluster, the name is ClusterPrimary for the primary cluster
+type executorFactory func(name string, addresses []string, username, password, spaceName string) (Executor, error)
+
+func newRouter(conf *Config, newExecutor executorFactory) (*router, error) {
+	switch conf.ReadPolicy {
+	case "", ReadPolicyPrimary, ReadPolicyReplica:
+	default:
+		return nil, fmt.Errorf("nebulaorm: unknown read policy %s", conf.ReadPolicy)
+	}
+	switch conf.PoolType {
+	case "", PoolTypeSession, PoolTypeConnection:
+	default:
+		return nil, fmt.Errorf("nebulaorm: unknown pool type %s", conf.PoolType)
+	}
+	pool, err := newExecutor(ClusterPrimary, conf.Addresses, conf.Username, conf.Password, conf.SpaceName)
+	if err != nil {
+		return nil, err
+	}
+	r := &router{
+		primary:    &cluster{name: ClusterPrimary, spaceName: conf.SpaceName, executor: pool},
+		clusters:   make(map[string]*cluster, len(conf.Clusters)+1),
+		readPolicy: conf.ReadPolicy,
+	}
+	r.clusters[ClusterPrimary] = r.primary
+	for _, clusterConf := range conf.Clusters {
+		if _, ok := r.clusters[clusterConf.Name]; ok || clusterConf.Name == "" {
+			r.close()
+			return nil, fmt.Errorf("nebulaorm: cluster name %q is empty or duplicated", clusterConf.Name)
+		}
+		username, password, spaceName := clusterConf.Username, clusterConf.Password, clusterConf.SpaceName
+		if username == "" {
+			username, password = conf.Username, conf.Password
+		}
+		if spaceName == "" {
+			spaceName = conf.SpaceName
+		}
+		pool, err = newExecutor(clusterConf.Name, clusterConf.Addresses, username, password, spaceName)
+		if err != nil {
+			r.close()
+			return nil, fmt.Errorf("nebulaorm: create pool of cluster %s failed: %w", clusterConf.Name, err)
+		}
+		c := &cluster{name: clusterConf.Name, spaceName: spaceName, executor: pool}
+		r.clusters[c.name] = c
+		if clusterConf.Replica {
+			r.replicas = append(r.replicas, c)
+		}
+	}
+	return r, nil
+}
+
+// newPoolFactory create the executors of the clusters according to Config.PoolType
+func newPoolFactory(conf *Config) executorFactory {
+	return func(_ string, addresses []string, username, password, spaceName string) (Executor, error) {
+		if conf.PoolType == PoolTypeConnection {
+			return newConnectionPool(conf, addresses, username, password, spaceName)
+		}
+		return newSessionPool(conf, addresses, username, password, spaceName)
+	}
+}
+
+// newGivenFactory use the given executors instead of creating pools, the executor of the additional cluster is given
+// by WithClusterExecutor
+func newGivenFactory(conf *Config, primary Executor) executorFactory {
+	return func(name string, _ []string, _, _, _ string) (Executor, error) {
+		if name == ClusterPrimary {
+			return primary, nil
+		}
+		executor, ok := conf.clusterExecutors[name]
+		if !ok || executor == nil {
+			return nil, fmt.Errorf("nebulaorm: %w, the executor of cluster %s is not given by WithClusterExecutor", ErrInvalidValue, name)
+		}
+		return executor, nil
+	}
+}
+
+// newExecutorRouter create a router that executes all the statements through the executor
+func newExecutorRouter(spaceName string, executor Executor) *router {
+	primary := &cluster{name: ClusterPrimary, spaceName: spaceName, executor: executor}
+	return &router{
+		primary:  primary,
+		clusters: map[string]*cluster{ClusterPrimary: primary},
+	}
+}
+
+// route get the cluster that the statement is executed on, the specified cluster takes precedence, otherwise write
+// statements are executed on the primary cluster and read statements are routed according to the read policy
+func (r *router) route(name string, readOnly bool) (*cluster, error) {
+	if name != "" {
+		c, ok := r.clusters[name]
+		if !ok {
+			return nil, fmt.Errorf("nebulaorm: %w, cluster %s is not configured", ErrInvalidValue, name)
+		}
+		return c, nil
+	}
+	if !readOnly || r.readPolicy != ReadPolicyReplica || len(r.replicas) == 0 {
+		return r.primary, nil
+	}
+	n := atomic.AddUint64(&r.next, 1)
+	return r.replicas[(n-1)%uint64(len(r.replicas))], nil
+}
+
+func (r *router) close() {
+	for _, c := range r.clusters {
+		c.executor.Close()
+	}
+}
@@ -0,0 +1,147 @@
+package nebulaorm
+
+import (
+	"fmt"
+	"github.com/haysons/nebulaorm/clause"
+	"github.com/haysons/nebulaorm/resolver"
+	"github.com/haysons/nebulaorm/statement"
+	"reflect"
+	"strings"
+)
+
+var edgeTypeNamerType = reflect.TypeOf((*resolver.EdgeTypeNamer)(nil)).Elem()
+
+// Save write the whole model, which is a vertex, an edge, or a slice of them, all the sentences are executed in one
+// request, and the number of the written items (a tag of a vertex or an edge) is returned.
+// an UPSERT VERTEX is generated for each tag of the vertex and an UPSERT EDGE for the edge, all the properties are
+// written even if they are zero, except that the zero autoCreateTime property is not written and the autoUpdateTime
+// property is always updated. the tag or edge without properties is inserted if it does not exist. BeforeUpdate of the
+// models is called before the sentences are built, so the changes made by the hook are written.
+//
+// UPSERT VERTEX ON player "player100" SET name = "Tim Duncan", age = 42; UPSERT VERTEX ON t1 "player100" SET p1 = 0;
+// db.Save(&player)
+func (db *DB) Save(value interface{}) (int, error) {
+	tx := db.getInstance()
+	models := reflect.Indirect(reflect.ValueOf(value))
+	if !models.IsValid() {
+		return 0, fmt.Errorf("nebulaorm: %w, the value of Save is nil", ErrInvalidValue)
+	}
+	if models.Kind() != reflect.Slice && models.Kind() != reflect.Array {
+		models = reflect.ValueOf([]interface{}{value})
+	}
+	modelValues := make([]reflect.Value, 0, models.Len())
+	for i := 0; i < models.Len(); i++ {
+		model := models.Index(i)
+		if model.Kind() == reflect.Interface {
+			model = model.Elem()
+		}
+		modelValues = append(modelValues, model)
+		tx.addModel(hookUpdate, model.Interface())
+	}
+	// the sentences are built from the models changed by the before hooks, which are not called again in execution
+	if err := tx.callBeforeHooks(); err != nil {
+		return 0, err
+	}
+	sentences := make([]string, 0, len(modelValues))
+	for _, model := range modelValues {
+		modelSentences, err := tx.saveSentences(model)
+		if err != nil {
+			return 0, err
+		}
+		sentences = append(sentences, modelSentences...)
+	}
+	if len(sentences) == 0 {
+		return 0, fmt.Errorf("nebulaorm: %w, nothing to save", ErrInvalidValue)
+	}
+	tx.Statement.Raw(strings.Join(sentences, " "))
+	if err := tx.Exec(); err != nil {
+		return 0, err
+	}
+	return len(sentences), nil
+}
+
+// saveSentences get the sentences to save the vertex or edge
+func (db *DB) saveSentences(model reflect.Value) ([]string, error) {
+	modelValue := reflect.Indirect(model)
+	if modelValue.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("nebulaorm: %w, Save only supports vertex, edge or a slice of them, got %s", ErrInvalidValue, model.Type())
+	}
+	if reflect.PtrTo(modelValue.Type()).Implements(edgeTypeNamerType) {
+		return db.saveEdgeSentences(modelValue)
+	}
+	return db.saveVertexSentences(modelValue)
+}
+
+func (db *DB) saveVertexSentences(vertexValue reflect.Value) ([]string, error) {
+	vertexSchema, err := resolver.ParseVertex(vertexValue.Type())
+	if err != nil {
+		return nil, err
+	}
+	vid := vertexSchema.GetVID(vertexValue)
+	sentences := make([]string, 0, len(vertexSchema.GetTags()))
+	for _, tag := range vertexSchema.GetTags() {
+		props := tag.GetProps()
+		if len(props) == 0 {
+			vidExpr := vertexSchema.GetVIDExpr(vertexValue)
+			if vidExpr == "" {
+				return nil, fmt.Errorf("nebulaorm: %w, the vid of the vertex is empty", ErrInvalidValue)
+			}
+			sentences = append(sentences, fmt.Sprintf("INSERT VERTEX IF NOT EXISTS %s() VALUES %s:();", tag.TagName, vidExpr))
+			continue
+		}
+		// the properties of the tag are declared in the vertex struct or in a field of it
+		tagValue := vertexValue
+		if index := props[0].StructField.Index; len(index) > 1 {
+			tagValue = vertexValue.Field(index[0])
+			if tagValue.Kind() == reflect.Ptr {
+				if tagValue.IsNil() {
+					continue
+				}
+				tagValue = tagValue.Elem()
+			}
+		}
+		nGQL, err := statement.New(statement.WithResolver(db.resolver)).
+			UpsertVertex(vid, tagValue.Interface(), clause.WithTagName(tag.TagName), clause.WithPropNames(savePropNames(props, tagValue))).
+			NGQL()
+		if err != nil {
+			return nil, err
+		}
+		sentences = append(sentences, nGQL)
+	}
+	return sentences, nil
+}
+
+func (db *DB) saveEdgeSentences(edgeValue reflect.Value) ([]string, error) {
+	edgeSchema, err := resolver.ParseEdge(edgeValue.Type())
+	if err != nil {
+		return nil, err
+	}
+	stmt := statement.New(statement.WithResolver(db.resolver))
+	if len(edgeSchema.GetProps()) == 0 {
+		stmt.InsertEdge(edgeValue.Interface(), true)
+	} else {
+		stmt.UpsertEdge(edgeValue.Interface(), edgeValue.Interface(), clause.WithPropNames(savePropNames(edgeSchema.GetProps(), edgeValue)))
+	}
+	nGQL, err := stmt.NGQL()
+	if err != nil {
+		return nil, err
+	}
+	return []string{nGQL}, nil
+}
+
+// savePropNames get the names of the properties to be written, the zero autoCreateTime property is skipped, and
+// the autoUpdateTime property is always updated by the update clause itself
+func savePropNames(props []*resolver.Prop, value reflect.Value) []string {
+	propNames := make([]string, 0, len(props))
+	for _, prop := range props {
+		if prop.AutoUpdateTime != resolver.AutoTimeNone {
+			continue
+		}
+		field := value.FieldByIndex(prop.StructField.Index[len(prop.StructField.Index)-1:])
+		if prop.AutoCreateTime != resolver.AutoTimeNone && field.IsZero() {
+			continue
+		}
+		propNames = append(propNames, prop.Name)
+	}
+	return propNames
+}
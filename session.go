@@ -0,0 +1,45 @@
+package nebulaorm
+
+import (
+	"fmt"
+	"github.com/haysons/nebulaorm/statement"
+)
+
+// Session is a DB whose statements are all executed on the same nebula graph session, so the session-scoped
+// settings such as variables and USE take effect on the subsequent statements. The Session is only valid in the
+// function passed to db.Session and is not concurrency-safe.
+type Session struct {
+	*DB
+}
+
+// Session pin a session of the cluster and execute fn with it, the session is released when fn returns. Since the
+// space of the session may be changed by USE, the statements are not bound to the space of the cluster, the model
+// space and db.Space still add the USE statement. The nested call reuses the pinned session. It requires
+// Config.PoolType to be PoolTypeConnection. In dry run mode, fn is executed without a session.
+func (db *DB) Session(fn func(s *Session) error) error {
+	tx := db.getInstance()
+	if tx.dryRun {
+		return fn(&Session{DB: tx.sessionDB(tx.router)})
+	}
+	c, err := tx.router.route(tx.cluster, false)
+	if err != nil {
+		return err
+	}
+	pinner, ok := c.executor.(SessionPinner)
+	if !ok {
+		return fmt.Errorf("nebulaorm: %w, the executor of cluster %s can not pin a session, set Config.PoolType to %s", ErrInvalidValue, c.name, PoolTypeConnection)
+	}
+	executor, release, err := pinner.PinSession()
+	if err != nil {
+		return err
+	}
+	defer release()
+	return fn(&Session{DB: tx.sessionDB(newExecutorRouter("", executor))})
+}
+
+// sessionDB create a new DB that shares the settings of db and executes statements through the router
+func (db *DB) sessionDB(r *router) *DB {
+	s := &DB{conf: db.conf, router: r, resolver: db.resolver, dryRun: db.dryRun, recorder: db.recorder, clone: 1}
+	s.Statement = statement.New(statement.WithResolver(s.resolver))
+	return s
+}
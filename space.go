@@ -0,0 +1,70 @@
+package nebulaorm
+
+import (
+	"fmt"
+	"github.com/haysons/nebulaorm/resolver"
+	"reflect"
+)
+
+// Space the statement will be executed in the specified graph space instead of Config.SpaceName, the session used to
+// execute the statement will be switched back to Config.SpaceName after execution.
+// if Space is not called, the space declared by the models through resolver.SpaceNamer is used, eg:
+//
+//	type player struct {
+//		VID  string `norm:"vertex_id"`
+//		Name string `norm:"prop:name"`
+//	}
+//
+//	func (p player) SpaceName() string {
+//		return "basketball"
+//	}
+//
+// USE basketball; INSERT VERTEX player(name) VALUES "player100":("Tim Duncan")
+// db.InsertVertex(&player{VID: "player100", Name: "Tim Duncan"}).Exec()
+func (db *DB) Space(name string) (tx *DB) {
+	tx = db.getInstance()
+	tx.space = name
+	return
+}
+
+// useModelSpace use the space declared by the type of dest if no space is specified
+func (db *DB) useModelSpace(dest interface{}) {
+	if db.space != "" || dest == nil {
+		return
+	}
+	db.space = resolver.GetSpaceName(reflect.TypeOf(dest))
+}
+
+// getSpace get the space in which the statement is executed, an empty string means the space of the connection
+func (db *DB) getSpace() (string, error) {
+	if db.space != "" {
+		return db.space, nil
+	}
+	var space string
+	for _, model := range db.models {
+		if model == nil {
+			continue
+		}
+		modelSpace := resolver.GetSpaceName(reflect.TypeOf(model))
+		if modelSpace == "" {
+			continue
+		}
+		if space != "" && space != modelSpace {
+			return "", fmt.Errorf("nebulaorm: %w, models belong to different spaces %s and %s", ErrInvalidValue, space, modelSpace)
+		}
+		space = modelSpace
+	}
+	return space, nil
+}
+
+// withSpace add the USE statement before nGQL if the space is different from the space of the connection
+func (db *DB) withSpace(nGQL string, connSpace string) (string, error) {
+	space, err := db.getSpace()
+	if err != nil {
+		return "", err
+	}
+	if space == "" || space == connSpace {
+		return nGQL, nil
+	}
+	return "USE " + space + "; " + nGQL, nil
+}
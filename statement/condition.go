@@ -0,0 +1,140 @@
+package statement
+
+import (
+	"errors"
+	"fmt"
+	"github.com/haysons/nebulaorm/clause"
+	"github.com/haysons/nebulaorm/resolver"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// modelCondition build the conditions from the non-zero properties of the struct or the entries of the map, the
+// conditions are joined by AND, and the property names are qualified according to the kind of the statement:
+// tag.prop in LOOKUP, properties(edge).prop for edges and properties($$).prop for vertices in GO.
+func (stmt *Statement) modelCondition(model interface{}) (clause.Expression, error) {
+	modelValue := reflect.Indirect(reflect.ValueOf(model))
+	switch modelValue.Kind() {
+	case reflect.Struct:
+		return stmt.structCondition(modelValue)
+	case reflect.Map:
+		if modelValue.Type().Key().Kind() != reflect.String {
+			return nil, errors.New("the key of the map condition must be string")
+		}
+		return stmt.mapCondition(modelValue)
+	default:
+		return nil, fmt.Errorf("unsupported condition type %T", model)
+	}
+}
+
+func (stmt *Statement) structCondition(modelValue reflect.Value) (clause.Expression, error) {
+	var name string
+	var isEdge bool
+	// the namer may be implemented by the pointer receiver
+	modelPtr := reflect.New(modelValue.Type())
+	modelPtr.Elem().Set(modelValue)
+	switch namer := modelPtr.Interface().(type) {
+	case resolver.EdgeTypeNamer:
+		name, isEdge = namer.EdgeTypeName(), true
+	case resolver.VertexTagNamer:
+		name = namer.VertexTagName()
+	default:
+		return nil, fmt.Errorf("the struct condition %s must implement VertexTagNamer or EdgeTypeNamer", modelValue.Type())
+	}
+	qualifier, err := stmt.propQualifier(name, isEdge)
+	if err != nil {
+		return nil, err
+	}
+	props, err := resolver.ParseStructProps(modelValue.Type())
+	if err != nil {
+		return nil, err
+	}
+	conds := make([]clause.Expression, 0, len(props))
+	for i, prop := range props {
+		if prop == nil {
+			continue
+		}
+		setting := prop.Setting
+		if setting[resolver.TagSettingIgnore] != "" || setting[resolver.TagSettingEdgeSrcID] != "" || setting[resolver.TagSettingEdgeDstID] != "" || setting[resolver.TagSettingEdgeRank] != "" || setting[resolver.TagSettingVertexID] != "" {
+			continue
+		}
+		fieldValue := modelValue.Field(i)
+		if fieldValue.IsZero() {
+			continue
+		}
+		valueFmt, err := prop.FormatValue(fieldValue)
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, clause.Eq{Prop: qualifier + prop.Name, Value: clause.Expr{Str: valueFmt}})
+	}
+	if len(conds) == 0 {
+		return nil, fmt.Errorf("the struct condition %s has no non-zero property", modelValue.Type())
+	}
+	return clause.And(conds...), nil
+}
+
+func (stmt *Statement) mapCondition(modelValue reflect.Value) (clause.Expression, error) {
+	if modelValue.Len() == 0 {
+		return nil, errors.New("the map condition is empty")
+	}
+	var qualifier string
+	keys := make([]string, 0, modelValue.Len())
+	for _, key := range modelValue.MapKeys() {
+		keys = append(keys, key.String())
+	}
+	// the map is traversed in the order of the keys, so that the generated statement is stable
+	sort.Strings(keys)
+	conds := make([]clause.Expression, 0, len(keys))
+	for _, key := range keys {
+		propName := key
+		// the property that is already qualified, such as player.name or properties($^).age, is used as it is
+		if !strings.ContainsAny(key, ".(") {
+			if qualifier == "" {
+				var err error
+				if qualifier, err = stmt.propQualifier(stmt.LastPart().lookupName(), true); err != nil {
+					return nil, err
+				}
+			}
+			propName = qualifier + key
+		}
+		value := modelValue.MapIndex(reflect.ValueOf(key).Convert(modelValue.Type().Key())).Interface()
+		rv := reflect.ValueOf(value)
+		if (rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() != reflect.Uint8) || rv.Kind() == reflect.Array {
+			conds = append(conds, clause.In{Prop: propName, Values: value})
+			continue
+		}
+		conds = append(conds, clause.Eq{Prop: propName, Value: value})
+	}
+	return clause.And(conds...), nil
+}
+
+// propQualifier get the prefix of the property according to the kind of the statement, the name is the tag name or
+// edge type name of the property
+func (stmt *Statement) propQualifier(name string, isEdge bool) (string, error) {
+	switch stmt.LastPart().GetType() {
+	case PartTypeLookup:
+		if name == "" {
+			return "", errors.New("the tag or edge type of the condition is unknown")
+		}
+		return name + ".", nil
+	case PartTypeGo:
+		if isEdge {
+			return "properties(edge).", nil
+		}
+		return "properties($$).", nil
+	default:
+		return "", errors.New("struct and map conditions are only supported in LOOKUP and GO statements, use the string condition in the other statements such as MATCH")
+	}
+}
+
+// lookupName get the tag or edge type name of the LOOKUP clause in the part
+func (p *Part) lookupName() string {
+	c, ok := p.clauses[clause.LookupName]
+	if !ok {
+		return ""
+	}
+	lookup, _ := c.Expression.(clause.Lookup)
+	return lookup.TypeName
+}
@@ -0,0 +1,87 @@
+package statement
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestStatementModelCondition(t *testing.T) {
+	tests := []struct {
+		stmt    func() *Statement
+		want    string
+		wantErr bool
+	}{
+		{
+			stmt: func() *Statement {
+				return New().Lookup("t2").Where(&t2{VID: "player100", Name: "Tim Duncan"}).Yield("id(vertex)")
+			},
+			want: `LOOKUP ON t2 WHERE t2.name == "Tim Duncan" YIELD id(vertex);`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Lookup("t3").Where(t3{P1: 3}).Yield("id(vertex)")
+			},
+			want: `LOOKUP ON t3 WHERE t3.p1 == 3 YIELD id(vertex);`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Lookup("t2").Where(map[string]interface{}{"name": "Tim Duncan", "age": []int{42, 43}}).Yield("id(vertex)")
+			},
+			want: `LOOKUP ON t2 WHERE t2.age IN [42, 43] AND t2.name == "Tim Duncan" YIELD id(vertex);`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Go().From("player100").Over("e2").Where(&e2{SrcID: "player100", Name: "Tim", Age: 42}).Or(map[string]interface{}{"properties($$).age": 30}).Yield("dst(edge)")
+			},
+			want: `GO FROM "player100" OVER e2 WHERE (properties(edge).name == "Tim" AND properties(edge).age == 42) OR properties($$).age == 30 YIELD dst(edge);`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Go().From("player100").Over("follow").Where(t2{Age: 42}).Where(map[string]interface{}{"degree": nil}).Yield("dst(edge)")
+			},
+			want: `GO FROM "player100" OVER follow WHERE properties($$).age == 42 AND properties(edge).degree IS NULL YIELD dst(edge);`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Go().From("player100").Over("follow").Where(map[string]interface{}{"degree": 95, "properties($$).age": 33}).Yield("dst(edge)")
+			},
+			want: `GO FROM "player100" OVER follow WHERE properties(edge).degree == 95 AND properties($$).age == 33 YIELD dst(edge);`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Lookup("t2").Where(&t2{VID: "player100"}).Yield("id(vertex)")
+			},
+			wantErr: true,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Lookup("t2").Where(struct{ Name string }{Name: "Tim"}).Yield("id(vertex)")
+			},
+			wantErr: true,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Fetch("t2", "player100").Where(t2{Age: 42})
+			},
+			wantErr: true,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Lookup("t2").Where(map[string]interface{}{"name": "Tim"}, 1)
+			},
+			wantErr: true,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("#_%d", i), func(t *testing.T) {
+			ngql, err := tt.stmt().NGQL()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NGQL() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if ngql != tt.want {
+				t.Errorf("NGQL = %v, want %v", ngql, tt.want)
+			}
+		})
+	}
+}
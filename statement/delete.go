@@ -1,6 +1,9 @@
 package statement
 
-import "github.com/haysons/nebulaorm/clause"
+import (
+	"fmt"
+	"github.com/haysons/nebulaorm/clause"
+)
 
 // DeleteVertex generate delete vertex clause
 //
@@ -59,3 +62,141 @@ func (stmt *Statement) DeleteEdge(edgeTypeName string, edges interface{}) *State
 	stmt.SetPartType(PartTypeDeleteEdge)
 	return stmt
 }
+
+// DeleteTag generate delete tag clause, the tags are specified by the names, the structs implementing the
+// resolver.VertexTagNamer interface, or a slice of them, and * deletes all the tags of the vertices, which can not be
+// mixed with other tag names. the nil pointer of the struct, such as (*player)(nil), only specifies the tag name.
+//
+// DELETE TAG player, team FROM "player100"
+// stmt.DeleteTag([]string{"player", "team"}, "player100")
+//
+// DELETE TAG player FROM "player100", "player101"
+// stmt.DeleteTag(&player{}, []string{"player100", "player101"})
+//
+// DELETE TAG * FROM $-.id
+// stmt.DeleteTag("*", clause.Expr{Str: "$-.id"})
+func (stmt *Statement) DeleteTag(tagNames interface{}, vid interface{}) *Statement {
+	stmt.AddClause(&clause.DeleteTag{
+		TagNames: tagNames,
+		VID:      vid,
+	})
+	stmt.SetPartType(PartTypeDeleteTag)
+	return stmt
+}
+
+// DeleteVertices delete the vertices matched by the LOOKUP or GO statement, the ids of the matched vertices are yielded
+// as the id column and piped into DELETE VERTEX. id(vertex) is yielded in LOOKUP and dst(edge) in GO, if the yield
+// clause is specified, it must yield the id column. the statement must have a where clause to avoid deleting all the
+// vertices by mistake, unless AllowGlobalDelete is called. the edge LOOKUP has no vertex, so the yield clause is required
+// to choose src(edge) or dst(edge) as the id column. the ORDER BY and LIMIT piped after the statement are kept, so the
+// vertices can be deleted in batches.
+//
+// LOOKUP ON player WHERE player.age > 40 YIELD id(vertex) AS id | DELETE VERTEX $-.id WITH EDGE
+// stmt.Lookup("player").Where("player.age > ?", 40).DeleteVertices(true)
+//
+// LOOKUP ON follow WHERE follow.degree < 60 YIELD dst(edge) AS id | DELETE VERTEX $-.id
+// stmt.LookupEdge("follow").Where("follow.degree < ?", 60).Yield("dst(edge) AS id").DeleteVertices()
+//
+// LOOKUP ON player WHERE player.age > 40 YIELD id(vertex) AS id | LIMIT 100 | DELETE VERTEX $-.id
+// stmt.Lookup("player").Where("player.age > ?", 40).Limit(100).DeleteVertices()
+func (stmt *Statement) DeleteVertices(withEdge ...bool) *Statement {
+	part := stmt.deleteQueryPart()
+	if part == nil {
+		stmt.err = fmt.Errorf("nebulaorm: %w, delete vertices is only supported after LOOKUP and GO statements, optionally followed by ORDER BY and LIMIT", clause.ErrInvalidClauseParams)
+		return stmt
+	}
+	var idExpr string
+	switch {
+	case part.GetType() == PartTypeGo:
+		idExpr = "dst(edge)"
+	case !part.IsOnEdge():
+		idExpr = "id(vertex)"
+	}
+	if !stmt.checkDeleteWhere(part, "vertices") {
+		return stmt
+	}
+	if _, ok := part.clauses[clause.YieldName]; !ok {
+		if idExpr == "" {
+			stmt.err = fmt.Errorf("nebulaorm: %w, delete vertices after the edge LOOKUP requires yielding src(edge) or dst(edge) AS id", clause.ErrInvalidClauseParams)
+			return stmt
+		}
+		part.AddClause(&clause.Yield{ExprList: []string{idExpr + " AS id"}})
+	}
+	return stmt.Pipe().DeleteVertex(clause.Expr{Str: "$-.id"}, withEdge...)
+}
+
+// DeleteEdges delete the edges matched by the LOOKUP or GO statement, the keys of the matched edges are yielded as the
+// src, dst and rank columns and piped into DELETE EDGE. the edge type is the one looked up by LookupEdge or the only one
+// traversed over, the LOOKUP on a tag is rejected. if the yield clause is specified, it must yield the src, dst and rank
+// columns. the statement must have a where clause to avoid deleting all the edges by mistake, unless AllowGlobalDelete
+// is called. the ORDER BY and LIMIT piped after the statement are kept, so the edges can be deleted in batches.
+//
+// GO FROM "player100" OVER serve WHERE properties(edge).start_year < 2000 YIELD src(edge) AS src, dst(edge) AS dst, rank(edge) AS rank | DELETE EDGE serve $-.src -> $-.dst @ $-.rank
+// stmt.Go().From("player100").Over("serve").Where("properties(edge).start_year < ?", 2000).DeleteEdges()
+//
+// LOOKUP ON serve WHERE serve.start_year < 2000 YIELD src(edge) AS src, dst(edge) AS dst, rank(edge) AS rank | DELETE EDGE serve $-.src -> $-.dst @ $-.rank
+// stmt.LookupEdge("serve").Where("serve.start_year < ?", 2000).DeleteEdges()
+func (stmt *Statement) DeleteEdges() *Statement {
+	part := stmt.deleteQueryPart()
+	if part == nil {
+		stmt.err = fmt.Errorf("nebulaorm: %w, delete edges is only supported after LOOKUP and GO statements, optionally followed by ORDER BY and LIMIT", clause.ErrInvalidClauseParams)
+		return stmt
+	}
+	var edgeTypeName string
+	if part.GetType() == PartTypeLookup {
+		if !part.IsOnEdge() {
+			stmt.err = fmt.Errorf("nebulaorm: %w, delete edges after LOOKUP requires the edge type looked up by LookupEdge", clause.ErrInvalidClauseParams)
+			return stmt
+		}
+		edgeTypeName = part.lookupName()
+	} else if c, ok := part.clauses[clause.OverName]; ok {
+		if over, _ := c.Expression.(clause.Over); len(over.EdgeTypeList) == 1 {
+			edgeTypeName = over.EdgeTypeList[0]
+		}
+	}
+	if edgeTypeName == "" || edgeTypeName == "*" {
+		stmt.err = fmt.Errorf("nebulaorm: %w, delete edges requires exactly one edge type", clause.ErrInvalidClauseParams)
+		return stmt
+	}
+	if !stmt.checkDeleteWhere(part, "edges") {
+		return stmt
+	}
+	if _, ok := part.clauses[clause.YieldName]; !ok {
+		part.AddClause(&clause.Yield{ExprList: []string{"src(edge) AS src, dst(edge) AS dst, rank(edge) AS rank"}})
+	}
+	return stmt.Pipe().DeleteEdge(edgeTypeName, "$-.src -> $-.dst @ $-.rank")
+}
+
+// deleteQueryPart get the LOOKUP or GO part whose results are deleted, the ORDER BY and LIMIT parts piped after it are
+// skipped, nil is returned if there is no such part
+func (stmt *Statement) deleteQueryPart() *Part {
+	for i := len(stmt.parts) - 1; i >= 0; i-- {
+		switch stmt.parts[i].GetType() {
+		case PartTypeOrder, PartTypeLimit:
+			continue
+		case PartTypeLookup, PartTypeGo:
+			return stmt.parts[i]
+		}
+		return nil
+	}
+	return nil
+}
+
+// AllowGlobalDelete allow DeleteVertices and DeleteEdges to delete all the items matched by the statement without
+// where clause, it must be called before them.
+func (stmt *Statement) AllowGlobalDelete() *Statement {
+	stmt.allowGlobalDelete = true
+	return stmt
+}
+
+// checkDeleteWhere check that the query part whose results are deleted has a where clause
+func (stmt *Statement) checkDeleteWhere(part *Part, items string) bool {
+	if stmt.allowGlobalDelete {
+		return true
+	}
+	if _, ok := part.clauses[clause.WhereName]; ok {
+		return true
+	}
+	stmt.err = fmt.Errorf("nebulaorm: %w, deleting the %s matched by the statement without where clause, call AllowGlobalDelete to delete all of them", clause.ErrMissingWhereClause, items)
+	return false
+}
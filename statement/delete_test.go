@@ -68,6 +68,115 @@ func TestDelete(t *testing.T) {
 			},
 			want: `DELETE EDGE serve "player100"->"team204", "player101"->"team204"@1;`,
 		},
+		{
+			stmt: func() *Statement {
+				return New().Lookup("player").Where("player.age > ?", 40).DeleteVertices(true)
+			},
+			want: `LOOKUP ON player WHERE player.age > 40 YIELD id(vertex) AS id | DELETE VERTEX $-.id WITH EDGE;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Go().From("player100").Over("follow").Where("properties($$).age > ?", 40).Yield("dst(edge) AS id").DeleteVertices()
+			},
+			want: `GO FROM "player100" OVER follow WHERE properties($$).age > 40 YIELD dst(edge) AS id | DELETE VERTEX $-.id;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Go().From("player100").Over("serve").Where("properties(edge).start_year < ?", 2000).DeleteEdges()
+			},
+			want: `GO FROM "player100" OVER serve WHERE properties(edge).start_year < 2000 YIELD src(edge) AS src, dst(edge) AS dst, rank(edge) AS rank | DELETE EDGE serve $-.src -> $-.dst @ $-.rank;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().LookupEdge("serve").AllowGlobalDelete().DeleteEdges()
+			},
+			want: `LOOKUP ON serve YIELD src(edge) AS src, dst(edge) AS dst, rank(edge) AS rank | DELETE EDGE serve $-.src -> $-.dst @ $-.rank;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Lookup("player").Where("player.age > ?", 40).Limit(100).DeleteVertices()
+			},
+			want: `LOOKUP ON player WHERE player.age > 40 YIELD id(vertex) AS id | LIMIT 100 | DELETE VERTEX $-.id;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().LookupEdge("serve").Where("serve.start_year < ?", 2000).
+					Yield("src(edge) AS src, dst(edge) AS dst, rank(edge) AS rank, serve.start_year AS year").OrderBy("$-.year").Limit(100).DeleteEdges()
+			},
+			want: `LOOKUP ON serve WHERE serve.start_year < 2000 YIELD src(edge) AS src, dst(edge) AS dst, rank(edge) AS rank, serve.start_year AS year | ORDER BY $-.year | LIMIT 100 | DELETE EDGE serve $-.src -> $-.dst @ $-.rank;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Go().From("player100").Over("serve").Where("properties(edge).start_year < ?", 2000).Limit(10).DeleteEdges()
+			},
+			want: `GO FROM "player100" OVER serve WHERE properties(edge).start_year < 2000 YIELD src(edge) AS src, dst(edge) AS dst, rank(edge) AS rank | LIMIT 10 | DELETE EDGE serve $-.src -> $-.dst @ $-.rank;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Lookup("player").Where("player.age > ?", 1).DeleteEdges()
+			},
+			wantErr: true,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Fetch("player", "player100").Yield("id(vertex) AS id").Limit(10).DeleteVertices()
+			},
+			wantErr: true,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Lookup("player").Limit(10).DeleteVertices()
+			},
+			wantErr: true,
+		},
+		{
+			stmt: func() *Statement {
+				return New().LookupEdge("follow").Where("follow.degree < ?", 60).Yield("dst(edge) AS id").DeleteVertices()
+			},
+			want: `LOOKUP ON follow WHERE follow.degree < 60 YIELD dst(edge) AS id | DELETE VERTEX $-.id;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Lookup("player").DeleteVertices()
+			},
+			wantErr: true,
+		},
+		{
+			stmt: func() *Statement {
+				return New().LookupEdge("follow").Where("follow.degree < ?", 60).DeleteVertices()
+			},
+			wantErr: true,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Go().From("player100").Over("serve", "follow").Where("properties(edge).degree > ?", 90).DeleteEdges()
+			},
+			wantErr: true,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Fetch("player", "player100").DeleteVertices()
+			},
+			wantErr: true,
+		},
+		{
+			stmt: func() *Statement {
+				return New().DeleteTag([]string{"player", "team"}, "player100")
+			},
+			want: `DELETE TAG player, team FROM "player100";`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Lookup("player").Where("player.age > ?", 40).Yield("id(vertex) AS id").Pipe().DeleteTag("*", clause.Expr{Str: "$-.id"})
+			},
+			want: `LOOKUP ON player WHERE player.age > 40 YIELD id(vertex) AS id | DELETE TAG * FROM $-.id;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().DeleteTag(nil, "player100")
+			},
+			wantErr: true,
+		},
 	}
 	for i, tt := range tests {
 		t.Run(fmt.Sprintf("#_%d", i), func(t *testing.T) {
@@ -60,6 +60,21 @@ import (
 //
 // INSERT VERTEX t3(p1), t4(p2) VALUES "21":(321, "hello"),
 // stmt.InsertVertex(v1{VID: "21", T1: t3{P1: 321}, T2: t4{P2: "hello"}})
+//
+// zero-valued properties can be filled with default values or the current time through the tag settings
+//
+//	type t5 struct {
+//		VID     string    `norm:"vertex_id"`
+//		Name    string    `norm:"prop:name;default:unknown"`
+//		Created time.Time `norm:"prop:created;autoCreateTime"`
+//		Updated int64     `norm:"prop:updated;autoUpdateTime:milli"`
+//	}
+//
+// INSERT VERTEX t5(name, created, updated) VALUES "31":("unknown", datetime("2024-08-20T11:16:30.000010"), 1724123790000)
+// stmt.InsertVertex(t5{VID: "31"})
+//
+// properties are validated according to the tag settings before building, such as `norm:"not null;type:fixed_string(32)"`,
+// an empty vertex id is also rejected, the error of all the invalid fields can be matched with ErrValidationFailed
 // more usage reference: ./insert_test.go
 func (stmt *Statement) InsertVertex(vertexes interface{}, ifNotExist ...bool) *Statement {
 	var notExistOpt bool
@@ -69,6 +84,7 @@ func (stmt *Statement) InsertVertex(vertexes interface{}, ifNotExist ...bool) *S
 	stmt.AddClause(&clause.InsertVertex{
 		IfNotExist: notExistOpt,
 		Vertexes:   reflect.ValueOf(vertexes),
+		Resolver:   stmt.rv,
 	})
 	stmt.SetPartType(PartTypeInsertVertex)
 	return stmt
@@ -107,6 +123,7 @@ func (stmt *Statement) InsertEdge(edges interface{}, ifNotExist ...bool) *Statem
 	stmt.AddClause(&clause.InsertEdge{
 		IfNotExist: notExistOpt,
 		Edges:      reflect.ValueOf(edges),
+		Resolver:   stmt.rv,
 	})
 	stmt.SetPartType(PartTypeInsertEdge)
 	return stmt
@@ -1,14 +1,22 @@
 package statement
 
 import (
+	"fmt"
 	"github.com/haysons/nebulaorm/clause"
+	"github.com/haysons/nebulaorm/resolver"
+	"reflect"
 	"strings"
 )
 
 // Raw execute any statement
 func (stmt *Statement) Raw(raw string) *Statement {
+	if len(stmt.assigns) > 0 {
+		stmt.err = fmt.Errorf("nebulaorm: %w, raw statement can not be used with variables", clause.ErrInvalidClauseParams)
+		return stmt
+	}
 	stmt.nGQL.WriteString(raw)
 	stmt.built = true
+	stmt.raw = true
 	return stmt
 }
 
@@ -106,7 +114,23 @@ func (stmt *Statement) Over(edgeType ...string) *Statement {
 //
 // WHERE v.player.name == "Tim Duncan" AND v.player.age > 30
 // stmt.Where("v.player.name == ?", "Tim Duncan").Where("v.player.age > ?", 30)
-func (stmt *Statement) Where(query string, args ...interface{}) *Statement {
+//
+// the query can also be a structured condition of package clause, the precedence of the conditions is kept
+// WHERE player.age > 30 AND (player.name == "Tim Duncan" OR player.name CONTAINS "Tony")
+// stmt.Where(clause.And(clause.Gt{Prop: "player.age", Value: 30}, clause.Or(clause.Eq{Prop: "player.name", Value: "Tim Duncan"}, clause.Contains{Prop: "player.name", Value: "Tony"})))
+//
+// the query can also be a struct or map, the non-zero properties are compared for equality and qualified by the statement kind
+// WHERE player.name == "Tim Duncan"
+// stmt.Lookup("player").Where(&Player{Name: "Tim Duncan"})
+//
+// in GO, the unqualified keys of the map are always the properties of the edge, the properties of the destination
+// vertex must be qualified by the caller, while the struct is qualified by whether it is a tag or an edge type
+// WHERE properties(edge).degree == 95 AND properties($$).age == 33
+// stmt.Go().From("player100").Over("follow").Where(map[string]interface{}{"degree": 95, "properties($$).age": 33})
+//
+// struct and map conditions are only supported in LOOKUP and GO, MATCH is not supported since the statement does not
+// build the MATCH clause, use the string condition such as v.player.name == ? instead
+func (stmt *Statement) Where(query interface{}, args ...interface{}) *Statement {
 	stmt.AddClause(&clause.Where{
 		Conditions: []clause.Condition{stmt.buildCondition(clause.OperatorAnd, query, args...)},
 	})
@@ -117,7 +141,7 @@ func (stmt *Statement) Where(query string, args ...interface{}) *Statement {
 //
 // WHERE properties(edge).degree > 90 OR properties($$).age != 33
 // stmt.Where("properties(edge).degree > ?", 90).Or("properties($$).age != ?", 33)
-func (stmt *Statement) Or(query string, args ...interface{}) *Statement {
+func (stmt *Statement) Or(query interface{}, args ...interface{}) *Statement {
 	stmt.AddClause(&clause.Where{
 		Conditions: []clause.Condition{stmt.buildCondition(clause.OperatorOr, query, args...)},
 	})
@@ -128,7 +152,7 @@ func (stmt *Statement) Or(query string, args ...interface{}) *Statement {
 //
 // WHERE NOT (v)-[e]->(t:team)
 // stmt.Where("NOT (v)-[e]->(t:team)")
-func (stmt *Statement) Not(query string, args ...interface{}) *Statement {
+func (stmt *Statement) Not(query interface{}, args ...interface{}) *Statement {
 	stmt.AddClause(&clause.Where{
 		Conditions: []clause.Condition{stmt.buildCondition(clause.OperatorNot, query, args...)},
 	})
@@ -139,20 +163,35 @@ func (stmt *Statement) Not(query string, args ...interface{}) *Statement {
 //
 // WHERE v.player.name == "Tim Duncan" XOR (v.player.age < 30 AND v.player.name == "Yao Ming")
 // stmt.Where("v.player.name == ?", "Tim Duncan").Xor("v.player.age < ? AND v.player.name == ?", 30, "Yao Ming")
-func (stmt *Statement) Xor(query string, args ...interface{}) *Statement {
+func (stmt *Statement) Xor(query interface{}, args ...interface{}) *Statement {
 	stmt.AddClause(&clause.Where{
 		Conditions: []clause.Condition{stmt.buildCondition(clause.OperatorXor, query, args...)},
 	})
 	return stmt
 }
 
-func (stmt *Statement) buildCondition(op string, query string, args ...interface{}) clause.Condition {
-	return clause.Condition{
-		Operator: op,
-		Expr: clause.Expr{
-			Str:  query,
-			Vars: args,
-		},
+// buildCondition build the condition from a query string with args, a structured condition such as clause.Eq, or a
+// struct or map whose properties are compared for equality
+func (stmt *Statement) buildCondition(op string, query interface{}, args ...interface{}) clause.Condition {
+	switch q := query.(type) {
+	case string:
+		return clause.Condition{Operator: op, Expr: clause.Expr{Str: q, Vars: args}}
+	case clause.Expression:
+		if len(args) > 0 {
+			stmt.err = fmt.Errorf("nebulaorm: %w, args are not supported by the structured condition", clause.ErrInvalidClauseParams)
+		}
+		return clause.Condition{Operator: op, Expr: q}
+	default:
+		if len(args) > 0 {
+			stmt.err = fmt.Errorf("nebulaorm: %w, args are not supported by the struct or map condition", clause.ErrInvalidClauseParams)
+			return clause.Condition{Operator: op, Expr: clause.Expr{}}
+		}
+		cond, err := stmt.modelCondition(query)
+		if err != nil {
+			stmt.err = fmt.Errorf("nebulaorm: %w, %v", clause.ErrInvalidClauseParams, err)
+			return clause.Condition{Operator: op, Expr: clause.Expr{}}
+		}
+		return clause.Condition{Operator: op, Expr: cond}
 	}
 }
 
@@ -192,9 +231,26 @@ func (stmt *Statement) Fetch(name string, vid interface{}) *Statement {
 		VID:   vid,
 	})
 	stmt.SetPartType(PartTypeFetch)
+	stmt.LastPart().SetOnEdge(isEdgeKeyExpr(vid))
 	return stmt
 }
 
+// isEdgeKeyExpr whether the expression passed to Fetch is the key of edges, such as "player100" -> "team204"
+func isEdgeKeyExpr(vid interface{}) bool {
+	switch v := vid.(type) {
+	case clause.Expr:
+		return strings.Contains(v.Str, "->")
+	case *clause.Expr:
+		return v != nil && strings.Contains(v.Str, "->")
+	case []clause.Expr:
+		return len(v) > 0 && isEdgeKeyExpr(v[0])
+	case []*clause.Expr:
+		return len(v) > 0 && isEdgeKeyExpr(v[0])
+	default:
+		return false
+	}
+}
+
 // FetchMulti generate fetch clause, multi vertex tag name or edge type name
 //
 // FETCH PROP ON player, serve "player101", "player102", "player103"
@@ -211,6 +267,27 @@ func (stmt *Statement) FetchMulti(names []string, vid interface{}) *Statement {
 	return stmt
 }
 
+// FetchEdge generate fetch clause for the edges, the edges can be the edge keys, edge structs or slices of them, and
+// the rank is omitted when it is 0
+//
+// FETCH PROP ON serve "player100"->"team204"@1
+// stmt.FetchEdge("serve", `"player100"->"team204"@1`)
+//
+// FETCH PROP ON serve "player100"->"team204", "player101"->"team204"@1
+// stmt.FetchEdge("serve", []edgeServe{{SrcID: "player100", DstID: "team204"}, {SrcID: "player101", DstID: "team204", Rank: 1}})
+func (stmt *Statement) FetchEdge(edgeTypeName string, edges interface{}) *Statement {
+	if edgeTypeName == "" || edges == nil {
+		return stmt
+	}
+	stmt.AddClause(&clause.Fetch{
+		Names: []string{edgeTypeName},
+		Edges: edges,
+	})
+	stmt.SetPartType(PartTypeFetch)
+	stmt.LastPart().SetOnEdge(true)
+	return stmt
+}
+
 // Lookup generate lookup clause
 //
 // LOOKUP ON player
@@ -226,6 +303,19 @@ func (stmt *Statement) Lookup(name string) *Statement {
 	return stmt
 }
 
+// LookupEdge generate lookup clause on the edge type, the statement is the same as Lookup, but the properties of the
+// edges are referenced through edge by YieldModel and DeleteVertices, and it is required by DeleteEdges
+//
+// LOOKUP ON serve
+// stmt.LookupEdge("serve")
+func (stmt *Statement) LookupEdge(name string) *Statement {
+	stmt.Lookup(name)
+	if name != "" {
+		stmt.LastPart().SetOnEdge(true)
+	}
+	return stmt
+}
+
 // GroupBy generate group by clause
 //
 // GROUP BY $-.Name
@@ -259,6 +349,142 @@ func (stmt *Statement) Yield(expr string, distinct ...bool) *Statement {
 	return stmt
 }
 
+// YieldModel generate yield clause from the columns of the record struct, so the yielded columns are kept in sync with
+// the fields scanned by Find. The expression of each column is decided by the statement kind: properties(edge).col in
+// GO, properties(vertex).col in FETCH and LOOKUP on tags, properties(edge).col in FETCH and LOOKUP on edge types (see
+// FetchEdge and LookupEdge), and $-.col after a pipe; the field of vertex or edge type yields the whole vertex or edge.
+// The yield setting of the field takes precedence, eg: norm:"col:team;yield:properties($$).name". In FETCH and LOOKUP,
+// the column of edge on tags, the column of vertex on edge types, and $$ or $^ are not available and return an error.
+//
+// YIELD properties(vertex).name AS name, properties(vertex).age AS age
+// stmt.Fetch("player", "player100").YieldModel(&PlayerRecord{})
+func (stmt *Statement) YieldModel(dest interface{}, distinct ...bool) *Statement {
+	if dest == nil {
+		stmt.err = fmt.Errorf("nebulaorm: %w, the dest of yield model is nil", clause.ErrInvalidClauseParams)
+		return stmt
+	}
+	record, err := resolver.ParseRecord(indirectElemType(reflect.TypeOf(dest)))
+	if err != nil {
+		stmt.err = fmt.Errorf("nebulaorm: %w, %v", clause.ErrInvalidClauseParams, err)
+		return stmt
+	}
+	exprList := make([]string, 0, len(record.GetCols()))
+	for _, col := range record.GetCols() {
+		expr, err := yieldColExpr(stmt.LastPart(), col)
+		if err != nil {
+			stmt.err = fmt.Errorf("nebulaorm: %w, %v", clause.ErrInvalidClauseParams, err)
+			return stmt
+		}
+		exprList = append(exprList, expr+" AS "+col.Name)
+	}
+	var distinctOpt bool
+	if len(distinct) > 0 {
+		distinctOpt = distinct[0]
+	}
+	stmt.AddClause(&clause.Yield{
+		Distinct: distinctOpt,
+		ExprList: exprList,
+	})
+	return stmt
+}
+
+var (
+	vertexIDStrType   = reflect.TypeOf((*resolver.VertexIDStr)(nil)).Elem()
+	vertexIDInt64Type = reflect.TypeOf((*resolver.VertexIDInt64)(nil)).Elem()
+	edgeTypeNamerType = reflect.TypeOf((*resolver.EdgeTypeNamer)(nil)).Elem()
+)
+
+// yieldColExpr get the expression of the column according to the statement kind and the type of the field
+func yieldColExpr(part *Part, col *resolver.RecordCol) (string, error) {
+	fieldType := col.Field.Type
+	if fieldType.Kind() != reflect.Ptr {
+		fieldType = reflect.PtrTo(fieldType)
+	}
+	isEdge := fieldType.Implements(edgeTypeNamerType)
+	isVertex := !isEdge && (fieldType.Implements(vertexIDStrType) || fieldType.Implements(vertexIDInt64Type))
+	switch part.GetType() {
+	case PartTypeGo:
+		if col.Yield != "" {
+			return col.Yield, nil
+		}
+		if isVertex {
+			return "$$", nil
+		}
+		if isEdge {
+			return "edge", nil
+		}
+		return "properties(edge)." + col.Name, nil
+	case PartTypeFetch, PartTypeLookup:
+		// only the fetched or looked up vertices or edges are available, there is no $$ or $^
+		if col.Yield != "" {
+			if strings.Contains(col.Yield, "$$") || strings.Contains(col.Yield, "$^") ||
+				(part.IsOnEdge() && containsWord(col.Yield, "vertex")) || (!part.IsOnEdge() && containsWord(col.Yield, "edge")) {
+				return "", fmt.Errorf("the yield expression %s of column %s is not available in FETCH and LOOKUP on %s", col.Yield, col.Name, partTarget(part))
+			}
+			return col.Yield, nil
+		}
+		if part.IsOnEdge() {
+			if isVertex {
+				return "", fmt.Errorf("the vertex column %s is not available in FETCH and LOOKUP on edge types", col.Name)
+			}
+			if isEdge {
+				return "edge", nil
+			}
+			return "properties(edge)." + col.Name, nil
+		}
+		if isEdge {
+			return "", fmt.Errorf("the edge column %s is not available in FETCH and LOOKUP on tags", col.Name)
+		}
+		if isVertex {
+			return "vertex", nil
+		}
+		return "properties(vertex)." + col.Name, nil
+	default:
+		if col.Yield != "" {
+			return col.Yield, nil
+		}
+		return "$-." + col.Name, nil
+	}
+}
+
+func partTarget(part *Part) string {
+	if part.IsOnEdge() {
+		return "edge types"
+	}
+	return "tags"
+}
+
+// containsWord whether the expression contains the identifier, such as edge in properties(edge).name
+func containsWord(expr string, word string) bool {
+	for i := strings.Index(expr, word); i >= 0; {
+		end := i + len(word)
+		if (i == 0 || !isIdentChar(expr[i-1])) && (end == len(expr) || !isIdentChar(expr[end])) {
+			return true
+		}
+		next := strings.Index(expr[end:], word)
+		if next < 0 {
+			break
+		}
+		i = end + next
+	}
+	return false
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// indirectElemType get the struct type of the dest, which may be a pointer, a slice or a pointer to a slice
+func indirectElemType(t reflect.Type) reflect.Type {
+	if t == nil {
+		return nil
+	}
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	return t
+}
+
 // OrderBy generate order by clause
 //
 // ORDER BY $-.age ASC, $-.name DESC
@@ -179,6 +179,80 @@ func TestQuery(t *testing.T) {
 			},
 			want: `GO FROM "player100" OVER follow WHERE properties(edge).degree > 90 XOR properties($$).age != 33 NOT properties($$).name != "Tony Parker" YIELD properties($$);`,
 		},
+		{
+			stmt: func() *Statement {
+				return New().Lookup("player").Where(clause.Or(clause.StartsWith{Prop: "player.name", Value: "T"}, clause.Gt{Prop: "player.age", Value: 40})).Where(clause.Neq{Prop: "player.age", Value: 42}).Yield("id(vertex)")
+			},
+			want: `LOOKUP ON player WHERE (player.name STARTS WITH "T" OR player.age > 40) AND player.age != 42 YIELD id(vertex);`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Lookup("player").Where(1).Yield("id(vertex)")
+			},
+			wantErr: true,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Fetch("t2", "player100").YieldModel(&[]yieldRecord{})
+			},
+			wantErr: true,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Fetch("t2", "player100").YieldModel(&[]vertexRecord{})
+			},
+			want: `FETCH PROP ON t2 "player100" YIELD properties(vertex).name AS name, properties(vertex).age AS age, vertex AS v;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().FetchEdge("e2", &e2{SrcID: "player100", DstID: "team204", Rank: 1}).YieldModel(&edgeRecord{})
+			},
+			want: `FETCH PROP ON e2 "player100"->"team204"@1 YIELD properties(edge).name AS name, edge AS e;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Fetch("e2", clause.Expr{Str: `"player100" -> "team204"`}).YieldModel(&edgeRecord{})
+			},
+			want: `FETCH PROP ON e2 "player100" -> "team204" YIELD properties(edge).name AS name, edge AS e;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().LookupEdge("e2").YieldModel(&edgeRecord{})
+			},
+			want: `LOOKUP ON e2 YIELD properties(edge).name AS name, edge AS e;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().LookupEdge("e2").YieldModel(&vertexRecord{})
+			},
+			wantErr: true,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Lookup("t2").YieldModel(&struct {
+					Name string `norm:"yield:properties(edge).name"`
+				}{})
+			},
+			wantErr: true,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Go().From("player100").Over("e2").YieldModel(yieldRecord{}, true).Pipe().YieldModel(&yieldRecord{})
+			},
+			want: `GO FROM "player100" OVER e2 YIELD DISTINCT properties(edge).name AS name, properties(edge).age AS age, properties(edge).team AS team, $$ AS v, edge AS e, properties($$).name AS dst_name | YIELD $-.name AS name, $-.age AS age, $-.team AS team, $-.v AS v, $-.e AS e, properties($$).name AS dst_name;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().FetchEdge("e2", &e2{SrcID: "player100", DstID: "team204", Rank: 1}).Yield("edge AS e")
+			},
+			want: `FETCH PROP ON e2 "player100"->"team204"@1 YIELD edge AS e;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Lookup("t2").YieldModel(nil)
+			},
+			wantErr: true,
+		},
 		{
 			stmt: func() *Statement {
 				return New().Yield("rand32(1, 6)")
@@ -214,3 +288,24 @@ func TestQuery(t *testing.T) {
 		})
 	}
 }
+
+type vertexRecord struct {
+	Name string
+	Age  int
+	V    *t2 `norm:"col:v"`
+}
+
+type edgeRecord struct {
+	Name string
+	E    e2 `norm:"col:e"`
+}
+
+type yieldRecord struct {
+	Name    string
+	Age     int `norm:"col:age"`
+	Team    *string
+	V       *t2    `norm:"col:v"`
+	E       e2     `norm:"col:e"`
+	DstName string `norm:"col:dst_name;yield:properties($$).name"`
+	Ignored string `norm:"-"`
+}
@@ -1,7 +1,9 @@
 package statement
 
 import (
+	"fmt"
 	"github.com/haysons/nebulaorm/clause"
+	"github.com/haysons/nebulaorm/resolver"
 	"strings"
 )
 
@@ -9,17 +11,41 @@ import (
 // A statement consists of multiple parts, which may be separated by '|', and each part consists of multiple clauses
 // that independently construct their own part of the statement. The statement object is not concurrency safe.
 type Statement struct {
-	parts []*Part
-	nGQL  *strings.Builder
-	built bool
-	err   error
+	parts   []*Part
+	assigns []assignment
+	nGQL    *strings.Builder
+	built   bool
+	raw     bool
+	err     error
+	rv      *resolver.Resolver
+
+	allowGlobalDelete bool
+}
+
+// Option the option of the statement
+type Option func(*Statement)
+
+// WithResolver the resolver whose conversion settings, such as timezone, are used when building the statement
+func WithResolver(rv *resolver.Resolver) Option {
+	return func(stmt *Statement) {
+		stmt.rv = rv
+	}
 }
 
-func New() *Statement {
-	return &Statement{
+func New(opts ...Option) *Statement {
+	stmt := &Statement{
 		parts: make([]*Part, 0),
 		nGQL:  new(strings.Builder),
 	}
+	for _, opt := range opts {
+		opt(stmt)
+	}
+	return stmt
+}
+
+// Resolver get the resolver of the statement, nil means the default resolver is used
+func (stmt *Statement) Resolver() *resolver.Resolver {
+	return stmt.rv
 }
 
 // LastPart gets the last part of the current statement.
@@ -44,6 +70,74 @@ func (stmt *Statement) Pipe() *Statement {
 	return stmt
 }
 
+// Assign assigns the result of the sub statement to the nGQL variable, the assignments are emitted as separate
+// sentences before the current statement and executed in the same request, the result of the last sentence is
+// returned. use clause.Var to reference the columns of the variable.
+//
+// $ids = GO FROM "player100" OVER follow YIELD dst(edge) AS id; FETCH PROP ON player $ids.id YIELD properties(vertex)
+// stmt.Assign("ids", New().Go().From("player100").Over("follow").Yield("dst(edge) AS id")).
+// Fetch("player", clause.Var("ids", "id")).Yield("properties(vertex)")
+func (stmt *Statement) Assign(name string, sub *Statement) *Statement {
+	switch {
+	case stmt.raw:
+		stmt.err = fmt.Errorf("nebulaorm: %w, variable %s can not be assigned in a raw statement", clause.ErrInvalidClauseParams, name)
+	case !isIdentifier(name):
+		stmt.err = fmt.Errorf("nebulaorm: %w, invalid variable name %q", clause.ErrInvalidClauseParams, name)
+	case sub == nil:
+		stmt.err = fmt.Errorf("nebulaorm: %w, the statement assigned to variable %s is nil", clause.ErrInvalidClauseParams, name)
+	case len(sub.assigns) > 0:
+		stmt.err = fmt.Errorf("nebulaorm: %w, the statement assigned to variable %s has its own variables, assign them in the outer statement", clause.ErrInvalidClauseParams, name)
+	default:
+		stmt.assigns = append(stmt.assigns, assignment{name: name, sub: sub})
+	}
+	return stmt
+}
+
+// Union combines the results of the current statement and the other statement and removes the duplicate rows, the
+// clauses added after it are piped on the combined results.
+//
+// (GO FROM "player100" OVER follow YIELD dst(edge) AS id UNION GO FROM "player101" OVER follow YIELD dst(edge) AS id) | LIMIT 1
+// stmt.Go().From("player100").Over("follow").Yield("dst(edge) AS id").
+// Union(New().Go().From("player101").Over("follow").Yield("dst(edge) AS id")).Limit(1)
+func (stmt *Statement) Union(other *Statement) *Statement {
+	return stmt.setOperation(CompositeTypeUnion, other)
+}
+
+// UnionAll combines the results of the current statement and the other statement and keeps the duplicate rows
+func (stmt *Statement) UnionAll(other *Statement) *Statement {
+	return stmt.setOperation(CompositeTypeUnionAll, other)
+}
+
+// Intersect returns the rows that are in the results of both the current statement and the other statement
+func (stmt *Statement) Intersect(other *Statement) *Statement {
+	return stmt.setOperation(CompositeTypeIntersect, other)
+}
+
+// Minus returns the rows that are in the results of the current statement but not in the other statement
+func (stmt *Statement) Minus(other *Statement) *Statement {
+	return stmt.setOperation(CompositeTypeMinus, other)
+}
+
+func (stmt *Statement) setOperation(typ CompositeType, other *Statement) *Statement {
+	switch {
+	case stmt.raw:
+		stmt.err = fmt.Errorf("nebulaorm: %w, set operation can not be used in a raw statement", clause.ErrInvalidClauseParams)
+		return stmt
+	case other == nil:
+		stmt.err = fmt.Errorf("nebulaorm: %w, the operand of the set operation is nil", clause.ErrInvalidClauseParams)
+		return stmt
+	case len(other.assigns) > 0:
+		stmt.err = fmt.Errorf("nebulaorm: %w, the operand of the set operation has variables, assign them in the outer statement", clause.ErrInvalidClauseParams)
+		return stmt
+	}
+	part := NewPart()
+	part.SetCompType(typ)
+	part.operand = other
+	stmt.AddPart(part)
+	// the subsequent clauses are piped on the results of the set operation
+	return stmt.Pipe()
+}
+
 // AddClause adds a clause to the last part of the statement.
 func (stmt *Statement) AddClause(v clause.Interface) {
 	part := stmt.LastPart()
@@ -69,29 +163,105 @@ func (stmt *Statement) Build() error {
 		return stmt.err
 	}
 	stmt.nGQL.Reset()
-	stmt.nGQL.Grow(100 * len(stmt.parts))
-	var firstPartBuilt bool
-	// generate statements for each part in turn
+	stmt.nGQL.Grow(100 * (len(stmt.parts) + len(stmt.assigns)))
+	// the variable assignments are written as the leading sentences
+	for i, assign := range stmt.assigns {
+		subNGQL, err := assign.sub.NGQL()
+		if err != nil {
+			stmt.err = err
+			return err
+		}
+		subNGQL = strings.TrimSuffix(strings.TrimSpace(subNGQL), ";")
+		if subNGQL == "" {
+			stmt.err = fmt.Errorf("nebulaorm: %w, the statement assigned to variable %s is empty", clause.ErrInvalidClauseParams, assign.name)
+			return stmt.err
+		}
+		if i > 0 {
+			stmt.nGQL.WriteString("; ")
+		}
+		stmt.nGQL.WriteByte('$')
+		stmt.nGQL.WriteString(assign.name)
+		stmt.nGQL.WriteString(" = ")
+		stmt.nGQL.WriteString(subNGQL)
+	}
+	body := new(strings.Builder)
+	if err := stmt.buildParts(body); err != nil {
+		stmt.err = err
+	}
+	if body.Len() > 0 && len(stmt.assigns) > 0 {
+		stmt.nGQL.WriteString("; ")
+	}
+	stmt.nGQL.WriteString(body.String())
+	stmt.nGQL.WriteByte(';')
+	stmt.built = true
+	return stmt.err
+}
+
+// buildParts generate the statements of all the parts in turn. The pipe binds tighter than the set operations in
+// nGQL, so the statement before the pipe is enclosed in parentheses if it contains set operations.
+func (stmt *Statement) buildParts(body *strings.Builder) error {
+	var firstPartBuilt, setOperated bool
 	for _, part := range stmt.parts {
-		if len(part.clauses) == 0 {
+		if len(part.clauses) == 0 && part.operand == nil {
 			continue
 		}
 		// add a connector between multiple statements based on the type of the compound statement
 		if firstPartBuilt {
 			switch part.compType {
 			case CompositeTypePipe:
-				stmt.nGQL.WriteString(" | ")
+				if setOperated {
+					enclosed := "(" + body.String() + ")"
+					body.Reset()
+					body.WriteString(enclosed)
+					setOperated = false
+				}
+				body.WriteString(" | ")
+			case CompositeTypeUnion:
+				body.WriteString(" UNION ")
+			case CompositeTypeUnionAll:
+				body.WriteString(" UNION ALL ")
+			case CompositeTypeIntersect:
+				body.WriteString(" INTERSECT ")
+			case CompositeTypeMinus:
+				body.WriteString(" MINUS ")
+			}
+			if part.compType != CompositeTypePipe {
+				setOperated = true
 			}
 		}
 		firstPartBuilt = true
-		if err := part.Build(stmt.nGQL); err != nil {
-			stmt.err = err
-			break
+		if part.operand == nil {
+			if err := part.Build(body); err != nil {
+				return err
+			}
+			continue
 		}
+		operand, err := part.operand.NGQL()
+		if err != nil {
+			return err
+		}
+		operand = strings.TrimSuffix(strings.TrimSpace(operand), ";")
+		if operand == "" {
+			return fmt.Errorf("nebulaorm: %w, the operand of the set operation is empty", clause.ErrInvalidClauseParams)
+		}
+		// the set operations are left-associative, so the operand containing set operations is enclosed
+		if part.operand.hasSetOperation() {
+			operand = "(" + operand + ")"
+		}
+		body.WriteString(operand)
 	}
-	stmt.nGQL.WriteByte(';')
-	stmt.built = true
-	return stmt.err
+	return nil
+}
+
+// hasSetOperation whether the statement contains set operations such as UNION
+func (stmt *Statement) hasSetOperation() bool {
+	for _, part := range stmt.parts {
+		switch part.compType {
+		case CompositeTypeUnion, CompositeTypeUnionAll, CompositeTypeIntersect, CompositeTypeMinus:
+			return true
+		}
+	}
+	return false
 }
 
 // NGQL build and return the nGQL statement, returning erring if there is a problem with the build
@@ -102,6 +272,49 @@ func (stmt *Statement) NGQL() (string, error) {
 	return stmt.nGQL.String(), nil
 }
 
+// ReadOnly whether the statement only reads data, it is used to route the statement to a read replica. the raw
+// statement is not considered read-only because its content is unknown.
+func (stmt *Statement) ReadOnly() bool {
+	if stmt.raw {
+		return false
+	}
+	for _, assign := range stmt.assigns {
+		if !assign.sub.ReadOnly() {
+			return false
+		}
+	}
+	for _, part := range stmt.parts {
+		if part.operand != nil && !part.operand.ReadOnly() {
+			return false
+		}
+		switch part.typ {
+		case PartTypeInsertVertex, PartTypeUpdateVertex, PartTypeDeleteVertex, PartTypeInsertEdge, PartTypeUpdateEdge, PartTypeDeleteEdge, PartTypeDeleteTag:
+			return false
+		}
+	}
+	return true
+}
+
+// assignment assigns the result of the sub statement to the nGQL variable
+type assignment struct {
+	name string
+	sub  *Statement
+}
+
+// isIdentifier whether the name can be used as the name of an nGQL variable
+func isIdentifier(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, c := range name {
+		if c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (i > 0 && c >= '0' && c <= '9') {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
 // Part is the part of the statement that actually contains the clause to be constructed and completes the construction
 // of the statement by calling the clause's Build method. Because the concept of a compound statement exists in nGQL,
 // it is necessary to add another layer to the statement concept to generate each part of the compound statement
@@ -110,6 +323,8 @@ type Part struct {
 	typ          PartType
 	setType      bool
 	compType     CompositeType
+	operand      *Statement
+	onEdge       bool // whether the FETCH or LOOKUP of the part is on an edge type
 	clauses      map[string]clause.Clause
 	clausesBuild []string
 }
@@ -136,6 +351,17 @@ func (p *Part) GetType() PartType {
 	return p.typ
 }
 
+// SetOnEdge marks that the FETCH or LOOKUP of the part is on an edge type rather than a vertex tag, so the properties
+// are referenced through edge instead of vertex
+func (p *Part) SetOnEdge(onEdge bool) {
+	p.onEdge = onEdge
+}
+
+// IsOnEdge whether the FETCH or LOOKUP of the part is on an edge type
+func (p *Part) IsOnEdge() bool {
+	return p.onEdge
+}
+
 // SetCompType sets the composite type of the current part. The composite type mainly determines how multiple
 // parts are separated in a composite statement, e.g., by the use of a pipe character.
 func (p *Part) SetCompType(typ CompositeType) {
@@ -180,6 +406,10 @@ type CompositeType int
 
 const (
 	CompositeTypePipe CompositeType = iota + 1
+	CompositeTypeUnion
+	CompositeTypeUnionAll
+	CompositeTypeIntersect
+	CompositeTypeMinus
 )
 
 type PartType int
@@ -197,6 +427,7 @@ const (
 	PartTypeInsertEdge
 	PartTypeUpdateEdge
 	PartTypeDeleteEdge
+	PartTypeDeleteTag
 )
 
 func (p *Part) getClausesBuild() []string {
@@ -228,6 +459,8 @@ func (p *Part) getClausesBuild() []string {
 		return []string{clause.UpdateEdgeName, clause.WhenName, clause.YieldName}
 	case PartTypeDeleteEdge:
 		return []string{clause.DeleteEdgeName}
+	case PartTypeDeleteTag:
+		return []string{clause.DeleteTagName}
 	default:
 		// The following clauses may not belong to a specific type of statement and can be used separately
 		return []string{clause.GroupName, clause.YieldName, clause.OrderName, clause.LimitName}
@@ -58,3 +58,166 @@ func TestStatement(t *testing.T) {
 		})
 	}
 }
+
+func TestStatementReadOnly(t *testing.T) {
+	tests := []struct {
+		stmt *Statement
+		want bool
+	}{
+		{stmt: New().Go().From("player100").Over("follow").Yield("dst(edge) AS id"), want: true},
+		{stmt: New().Fetch("player", "player100").Yield("properties(vertex)").Pipe().Limit(1), want: true},
+		{stmt: New().Go().From("player100").Over("follow").Yield("dst(edge) AS id").Pipe().DeleteVertex(clause.Expr{Str: "$-.id"}), want: false},
+		{stmt: New().UpdateVertex("player100", map[string]interface{}{"age": 30}), want: false},
+		{stmt: New().DeleteTag("player", "player100"), want: false},
+		{stmt: New().Raw("MATCH (v:player) RETURN v LIMIT 1"), want: false},
+		{stmt: New().Assign("ids", New().Lookup("player").Yield("id(vertex) AS id")).DeleteVertex(clause.Var("ids", "id")), want: false},
+		{stmt: New().Assign("ids", New().Lookup("player").Yield("id(vertex) AS id")).Fetch("player", clause.Var("ids", "id")), want: true},
+		{stmt: New().Lookup("player").Yield("id(vertex) AS id").Minus(New().Lookup("team").Yield("id(vertex) AS id")), want: true},
+		{stmt: New().Lookup("player").Yield("id(vertex) AS id").Union(New().Raw("DELETE VERTEX \"player100\"")), want: false},
+	}
+	for i, tt := range tests {
+		if got := tt.stmt.ReadOnly(); got != tt.want {
+			t.Errorf("case #%d ReadOnly() got = %v, want %v", i, got, tt.want)
+		}
+	}
+}
+
+func TestStatementAssign(t *testing.T) {
+	tests := []struct {
+		stmt    func() *Statement
+		want    string
+		wantErr bool
+	}{
+		{
+			stmt: func() *Statement {
+				return New().Assign("ids", New().Go().From("player100").Over("follow").Yield("dst(edge) AS id")).
+					Fetch("player", clause.Var("ids", "id")).Yield("properties(vertex).name AS name")
+			},
+			want: `$ids = GO FROM "player100" OVER follow YIELD dst(edge) AS id; FETCH PROP ON player $ids.id YIELD properties(vertex).name AS name;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().
+					Assign("a", New().Go().From("player100").Over("follow").Yield("dst(edge) AS id")).
+					Assign("b", New().Go().From("player101").Over("follow").Yield("dst(edge) AS id")).
+					Go().From(clause.Var("a", "id")).Over("serve").Yield("dst(edge) AS id").Pipe().Limit(1)
+			},
+			want: `$a = GO FROM "player100" OVER follow YIELD dst(edge) AS id; $b = GO FROM "player101" OVER follow YIELD dst(edge) AS id; GO FROM $a.id OVER serve YIELD dst(edge) AS id | LIMIT 1;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Assign("v", New().Raw("LOOKUP ON player YIELD id(vertex) AS id;"))
+			},
+			want: `$v = LOOKUP ON player YIELD id(vertex) AS id;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Assign("1v", New().Go().From("player100").Over("follow"))
+			},
+			wantErr: true,
+		},
+		{
+			stmt: func() *Statement {
+				sub := New().Assign("a", New().Go().From("player100").Over("follow"))
+				return New().Assign("b", sub)
+			},
+			wantErr: true,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Assign("a", New().Go().From("player100").Over("follow")).Raw("SHOW TAGS")
+			},
+			wantErr: true,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Assign("a", New().Fetch("player", nil)).Fetch("player", clause.Var("a", "id"))
+			},
+			wantErr: true,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("#_%d", i), func(t *testing.T) {
+			ngql, err := tt.stmt().NGQL()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NGQL() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if ngql != tt.want {
+				t.Errorf("NGQL = %v, want %v", ngql, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatementSetOperation(t *testing.T) {
+	goFollow := func(vid string) *Statement {
+		return New().Go().From(vid).Over("follow").Yield("dst(edge) AS id")
+	}
+	tests := []struct {
+		stmt    func() *Statement
+		want    string
+		wantErr bool
+	}{
+		{
+			stmt: func() *Statement {
+				return goFollow("player100").Union(goFollow("player101"))
+			},
+			want: `GO FROM "player100" OVER follow YIELD dst(edge) AS id UNION GO FROM "player101" OVER follow YIELD dst(edge) AS id;`,
+		},
+		{
+			stmt: func() *Statement {
+				return goFollow("player100").UnionAll(goFollow("player101")).Intersect(goFollow("player102")).Minus(goFollow("player103"))
+			},
+			want: `GO FROM "player100" OVER follow YIELD dst(edge) AS id UNION ALL GO FROM "player101" OVER follow YIELD dst(edge) AS id INTERSECT GO FROM "player102" OVER follow YIELD dst(edge) AS id MINUS GO FROM "player103" OVER follow YIELD dst(edge) AS id;`,
+		},
+		{
+			stmt: func() *Statement {
+				return goFollow("player100").Union(goFollow("player101")).Limit(1)
+			},
+			want: `(GO FROM "player100" OVER follow YIELD dst(edge) AS id UNION GO FROM "player101" OVER follow YIELD dst(edge) AS id) | LIMIT 1;`,
+		},
+		{
+			stmt: func() *Statement {
+				return goFollow("player100").Union(goFollow("player101")).Pipe().Go().From(clause.Expr{Str: "$-.id"}).Over("serve").Yield("dst(edge) AS id")
+			},
+			want: `(GO FROM "player100" OVER follow YIELD dst(edge) AS id UNION GO FROM "player101" OVER follow YIELD dst(edge) AS id) | GO FROM $-.id OVER serve YIELD dst(edge) AS id;`,
+		},
+		{
+			stmt: func() *Statement {
+				return goFollow("player100").Minus(goFollow("player101").Union(goFollow("player102")))
+			},
+			want: `GO FROM "player100" OVER follow YIELD dst(edge) AS id MINUS (GO FROM "player101" OVER follow YIELD dst(edge) AS id UNION GO FROM "player102" OVER follow YIELD dst(edge) AS id);`,
+		},
+		{
+			stmt: func() *Statement {
+				return goFollow("player100").Pipe().Limit(1).Union(goFollow("player101").Pipe().Limit(1))
+			},
+			want: `GO FROM "player100" OVER follow YIELD dst(edge) AS id | LIMIT 1 UNION GO FROM "player101" OVER follow YIELD dst(edge) AS id | LIMIT 1;`,
+		},
+		{
+			stmt: func() *Statement {
+				return goFollow("player100").Union(nil)
+			},
+			wantErr: true,
+		},
+		{
+			stmt: func() *Statement {
+				return goFollow("player100").Union(New())
+			},
+			wantErr: true,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("#_%d", i), func(t *testing.T) {
+			ngql, err := tt.stmt().NGQL()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NGQL() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if ngql != tt.want {
+				t.Errorf("NGQL = %v, want %v", ngql, tt.want)
+			}
+		})
+	}
+}
@@ -1,7 +1,11 @@
 package statement
 
 import (
+	"fmt"
 	"github.com/haysons/nebulaorm/clause"
+	"github.com/haysons/nebulaorm/resolver"
+	"reflect"
+	"strings"
 )
 
 // UpdateVertex generate update vertex clause
@@ -22,18 +26,38 @@ import (
 // UPDATE VERTEX ON t2 "10" SET name = "hayson
 // stmt.UpdateVertex("10", &t2{Name: "hayson"})
 //
+// properties with the autoUpdateTime tag setting are always updated, the current time is used if it is zero.
+//
 // if you want to update a zero-valued field, you can proactively specify the field to be updated
 //
 // UPDATE VERTEX ON t2 "10" SET name = "hayson", age = 0
 // stmt.UpdateVertex("10", &t2{Name: "hayson"}, clause.WithPropNames([]string{"name", "age"}))
 //
+// nullable types such as resolver.NullString distinguish "unset", "set to zero" and "set to NULL": the zero value is not
+// updated, a valid value is always updated even if it is zero, and a non-nil pointer to an invalid value updates the
+// property to NULL.
+//
+//	type t3 struct {
+//		Name *resolver.NullString `norm:"prop:name"`
+//		Age  resolver.NullInt64   `norm:"prop:age"`
+//	}
+//
+// UPDATE VERTEX ON t3 "10" SET name = NULL, age = 0
+// stmt.UpdateVertex("10", &t3{Name: &resolver.NullString{}, Age: resolver.NullInt64{Valid: true}})
+//
 // you can also use map[string]interface{} to update, especially if you need to update a field as an expression, in which case
-// you have to manually specify the name of the tag that needs to be updated.
+// you have to manually specify the name of the tag that needs to be updated. a nil value in the map updates the property to NULL.
 //
 // UPDATE VERTEX ON player "player101" SET age = age + 2 WHEN name == "Tony Parker" YIELD name AS Name, age AS Age
 // stmt.UpdateVertex("player101", map[string]interface{}{"age": clause.Expr{Str: "age + 2"}}, clause.WithTagName("player")).
 // When("name == ?", "Tony Parker").Yield("name AS Name, age AS Age")
 //
+// the typed assignments such as clause.Incr, clause.Decr, clause.Append and clause.Coalesce build the expressions
+// referencing the property itself, they can be used as the update value or appended to the struct update by clause.WithSet
+//
+// UPDATE VERTEX ON t2 "10" SET name = "hayson", age = age + 1
+// stmt.UpdateVertex("10", &t2{Name: "hayson"}, clause.WithSet(clause.Incr("age", 1)))
+//
 // other uses can be found in./update_test
 func (stmt *Statement) UpdateVertex(vid interface{}, tagUpdate interface{}, opts ...clause.Option) *Statement {
 	updateOpts := new(clause.Options)
@@ -45,6 +69,7 @@ func (stmt *Statement) UpdateVertex(vid interface{}, tagUpdate interface{}, opts
 		VID:       vid,
 		TagUpdate: tagUpdate,
 		Opts:      *updateOpts,
+		Resolver:  stmt.rv,
 	})
 	stmt.SetPartType(PartTypeUpdateVertex)
 	return stmt
@@ -62,6 +87,7 @@ func (stmt *Statement) UpsertVertex(vid interface{}, tagUpdate interface{}, opts
 		VID:       vid,
 		TagUpdate: tagUpdate,
 		Opts:      *updateOpts,
+		Resolver:  stmt.rv,
 	})
 	stmt.SetPartType(PartTypeUpdateVertex)
 	return stmt
@@ -110,6 +136,7 @@ func (stmt *Statement) UpdateEdge(edge interface{}, propsUpdate interface{}, opt
 		Edge:        edge,
 		PropsUpdate: propsUpdate,
 		Opts:        *updateOpts,
+		Resolver:    stmt.rv,
 	})
 	stmt.SetPartType(PartTypeUpdateEdge)
 	return stmt
@@ -127,6 +154,7 @@ func (stmt *Statement) UpsertEdge(edge interface{}, propsUpdate interface{}, opt
 		Edge:        edge,
 		PropsUpdate: propsUpdate,
 		Opts:        *updateOpts,
+		Resolver:    stmt.rv,
 	})
 	stmt.SetPartType(PartTypeUpdateEdge)
 	return stmt
@@ -134,8 +162,8 @@ func (stmt *Statement) UpsertEdge(edge interface{}, propsUpdate interface{}, opt
 
 // When mainly used to generate when clause in update type statements
 // specific usage reference Where
-func (stmt *Statement) When(query string, args ...interface{}) *Statement {
-	if query == "" {
+func (stmt *Statement) When(query interface{}, args ...interface{}) *Statement {
+	if query == nil || query == "" {
 		return stmt
 	}
 	stmt.AddClause(&clause.When{
@@ -143,3 +171,67 @@ func (stmt *Statement) When(query string, args ...interface{}) *Statement {
 	})
 	return stmt
 }
+
+// Returning generate the yield clause of the update statement from the dest struct, each property field of the dest is
+// yielded by the property name as the column of the field, the vertex id and edge key fields are skipped, and the field
+// with the yield setting is yielded by the specified expression. exprs are the additional expressions to be yielded,
+// Returning can not be used with Yield, pass the expressions to be yielded through exprs instead.
+//
+// every property field of the dest is yielded, not only the updated ones, so the dest must only declare the properties
+// of the updated tag or edge, or the yield setting of the field, otherwise the statement fails on the server.
+//
+// UPDATE VERTEX ON player "player101" SET age = age + 2 YIELD name AS name, age AS age, id($^) AS vid
+// stmt.UpdateVertex("player101", map[string]interface{}{"age": clause.Expr{Str: "age + 2"}}, clause.WithTagName("player")).
+// Returning(&player{}, "id($^) AS vid")
+func (stmt *Statement) Returning(dest interface{}, exprs ...string) *Statement {
+	partType := stmt.LastPart().GetType()
+	if partType != PartTypeUpdateVertex && partType != PartTypeUpdateEdge {
+		stmt.err = fmt.Errorf("nebulaorm: %w, returning is only supported in update statements", clause.ErrInvalidClauseParams)
+		return stmt
+	}
+	if dest == nil {
+		stmt.err = fmt.Errorf("nebulaorm: %w, the dest of returning is nil", clause.ErrInvalidClauseParams)
+		return stmt
+	}
+	if _, ok := stmt.LastPart().clauses[clause.YieldName]; ok {
+		stmt.err = fmt.Errorf("nebulaorm: %w, returning can not be used with yield, pass the expressions through exprs", clause.ErrInvalidClauseParams)
+		return stmt
+	}
+	record, err := resolver.ParseRecord(indirectElemType(reflect.TypeOf(dest)))
+	if err != nil {
+		stmt.err = fmt.Errorf("nebulaorm: %w, %v", clause.ErrInvalidClauseParams, err)
+		return stmt
+	}
+	exprList := make([]string, 0, len(record.GetCols())+len(exprs))
+	for _, col := range record.GetCols() {
+		expr := col.Yield
+		if expr == "" {
+			setting := resolver.ParseTagSetting(col.Field.Tag.Get(resolver.TagSettingKey))
+			if _, ok := setting[resolver.TagSettingVertexID]; ok {
+				continue
+			}
+			if _, ok := setting[resolver.TagSettingEdgeSrcID]; ok {
+				continue
+			}
+			if _, ok := setting[resolver.TagSettingEdgeDstID]; ok {
+				continue
+			}
+			if _, ok := setting[resolver.TagSettingEdgeRank]; ok {
+				continue
+			}
+			expr = resolver.GetPropName(col.Field)
+		}
+		exprList = append(exprList, expr+" AS "+col.Name)
+	}
+	for _, expr := range exprs {
+		if expr = strings.TrimSpace(expr); expr != "" {
+			exprList = append(exprList, expr)
+		}
+	}
+	if len(exprList) == 0 {
+		stmt.err = fmt.Errorf("nebulaorm: %w, nothing to return", clause.ErrInvalidClauseParams)
+		return stmt
+	}
+	stmt.AddClause(&clause.Yield{ExprList: exprList})
+	return stmt
+}
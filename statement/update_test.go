@@ -3,7 +3,11 @@ package statement
 import (
 	"fmt"
 	"github.com/haysons/nebulaorm/clause"
+	"github.com/haysons/nebulaorm/resolver"
+	"regexp"
+	"strconv"
 	"testing"
+	"time"
 )
 
 func TestUpdate(t *testing.T) {
@@ -30,6 +34,30 @@ func TestUpdate(t *testing.T) {
 			},
 			want: `UPDATE VERTEX ON t2 "10" SET name = "hayson", age = 0;`,
 		},
+		{
+			stmt: func() *Statement {
+				return New().UpdateVertex("10", &nullPlayer{Name: &resolver.NullString{}, Age: resolver.NullInt64{Valid: true}})
+			},
+			want: `UPDATE VERTEX ON t3 "10" SET name = NULL, age = 0;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().UpdateVertex("10", &nullPlayer{Name: &resolver.NullString{String: "hayson", Valid: true}})
+			},
+			want: `UPDATE VERTEX ON t3 "10" SET name = "hayson";`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().UpdateVertex("10", &auditPlayer{Name: "hayson", Updated: 1724123790}, clause.WithPropNames([]string{"name"}))
+			},
+			want: `UPDATE VERTEX ON t4 "10" SET name = "hayson", updated = 1724123790;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().UpdateVertex("10", map[string]interface{}{"name": nil}, clause.WithTagName("t3"))
+			},
+			want: `UPDATE VERTEX ON t3 "10" SET name = NULL;`,
+		},
 		{
 			stmt: func() *Statement {
 				return New().UpdateVertex("player101", map[string]interface{}{"age": clause.Expr{Str: "age + 2"}}, clause.WithTagName("player")).
@@ -80,7 +108,61 @@ func TestUpdate(t *testing.T) {
 			stmt: func() *Statement {
 				return New().UpsertEdge(e2{SrcID: "player668", DstID: "team200"}, map[string]interface{}{"start_year": 2000, "end_year": clause.Expr{Str: "end_year + 1"}}).Yield("start_year, end_year")
 			},
-			want: `UPSERT EDGE ON e2 "player668"->"team200" SET start_year = 2000, end_year = end_year + 1 YIELD start_year, end_year;`,
+			want: `UPSERT EDGE ON e2 "player668"->"team200" SET end_year = end_year + 1, start_year = 2000 YIELD start_year, end_year;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().UpdateVertex("10", &t2{Name: "hayson"}, clause.WithSet(clause.Incr("age", 1)))
+			},
+			want: `UPDATE VERTEX ON t2 "10" SET name = "hayson", age = age + 1;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().UpdateEdge(e2{SrcID: "player100", DstID: "team204"}, clause.Decr("age", 1)).Returning(&e2{})
+			},
+			want: `UPDATE EDGE ON e2 "player100"->"team204" SET age = age - 1 YIELD name AS name, age AS age;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().UpdateVertex("10", &t2{Age: 26}).When("age < ?", 26).Returning(&t2{}, "id($^) AS vid")
+			},
+			want: `UPDATE VERTEX ON t2 "10" SET age = 26 WHEN age < 26 YIELD name AS name, age AS age, id($^) AS vid;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().UpsertEdge(e2{SrcID: "player100", DstID: "team204", Rank: 1}, &e2{Age: 26}).Returning(&e2{})
+			},
+			want: `UPSERT EDGE ON e2 "player100"->"team204"@1 SET age = 26 YIELD name AS name, age AS age;`,
+		},
+		{
+			stmt: func() *Statement {
+				return New().UpdateVertex("", &t2{Name: "hayson"})
+			},
+			wantErr: true,
+		},
+		{
+			stmt: func() *Statement {
+				return New().UpsertVertex("", &t2{Name: "hayson"})
+			},
+			wantErr: true,
+		},
+		{
+			stmt: func() *Statement {
+				return New().Fetch("player", "player100").Returning(&t2{})
+			},
+			wantErr: true,
+		},
+		{
+			stmt: func() *Statement {
+				return New().UpdateVertex("10", &t2{Age: 26}).Returning(nil)
+			},
+			wantErr: true,
+		},
+		{
+			stmt: func() *Statement {
+				return New().UpdateVertex("10", &t2{Age: 26}).Yield("name").Returning(&t2{})
+			},
+			wantErr: true,
 		},
 	}
 	for i, tt := range tests {
@@ -100,8 +182,43 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+func TestUpdateAutoUpdateTime(t *testing.T) {
+	// the zero auto update time property is filled with the current time, even if it is specified by WithPropNames
+	before := time.Now().Unix()
+	ngql, err := New().UpdateVertex("10", &auditPlayer{Name: "hayson"}, clause.WithPropNames([]string{"name", "updated"})).NGQL()
+	if err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+	matches := regexp.MustCompile(`^UPDATE VERTEX ON t4 "10" SET name = "hayson", updated = (\d+);$`).FindStringSubmatch(ngql)
+	if matches == nil {
+		t.Fatalf("NGQL = %v, want the updated with the current time", ngql)
+	}
+	if updated, _ := strconv.ParseInt(matches[1], 10, 64); updated < before {
+		t.Errorf("updated = %v, want not before %v", updated, before)
+	}
+}
+
 type playerUpdate map[string]interface{}
 
 func (m playerUpdate) VertexTagName() string {
 	return "player"
 }
+
+type nullPlayer struct {
+	Name *resolver.NullString `norm:"prop:name"`
+	Age  resolver.NullInt64   `norm:"prop:age"`
+}
+
+func (t nullPlayer) VertexTagName() string {
+	return "t3"
+}
+
+type auditPlayer struct {
+	Name    string `norm:"prop:name"`
+	Created int64  `norm:"prop:created;autoCreateTime"`
+	Updated int64  `norm:"prop:updated;autoUpdateTime"`
+}
+
+func (t auditPlayer) VertexTagName() string {
+	return "t4"
+}